@@ -0,0 +1,225 @@
+/*
+Package fakeconnection provides a fake connection.BasicConnection for tests, it routes each request to the handler
+registered for its method and URI and records every request
+*/
+package fakeconnection
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Request is a request made to the fake connection
+type Request struct {
+	Method string
+	URI    string
+	Params map[string]string
+	Data   []byte
+}
+
+// String describes a request, for example "POST job_templates/7/launch/"
+func (request Request) String() string {
+	return request.Method + " " + request.URI
+}
+
+// ID gets the first numeric segment of the URI of a request, for example 7 for job_templates/7/launch/, 0 for none
+func (request Request) ID() int32 {
+	for _, segment := range strings.Split(request.URI, "/") {
+		if id, err := strconv.ParseInt(segment, 10, 32); err == nil {
+			return int32(id)
+		}
+	}
+
+	return 0
+}
+
+// Decode decodes the JSON data of a request into a struct
+//
+//	:param data: A pointer to the struct to decode into
+func (request Request) Decode(data any) error {
+	return json.Unmarshal(request.Data, data)
+}
+
+// Handler answers a request
+type Handler func(request Request) (*http.Response, error)
+
+// route is a handler registered for a method and a URI or URI prefix
+type route struct {
+	method  string
+	uri     string
+	prefix  bool
+	handler Handler
+}
+
+// Connection is a fake connection.BasicConnection, it is safe for concurrent use
+type Connection struct {
+	mutex    sync.Mutex
+	routes   []route
+	requests []Request
+	// Fallback answers the requests no route matches, nil answers 404
+	Fallback Handler
+}
+
+// New creates a new fake connection without routes
+func New() *Connection {
+	return &Connection{}
+}
+
+// Handle registers a handler for the requests of a method to a URI
+//
+//	:param method: The HTTP method, for example http.MethodGet
+//	:param uri: The URI
+//	:param handler: The handler
+func (connection *Connection) Handle(method string, uri string, handler Handler) *Connection {
+	connection.mutex.Lock()
+	defer connection.mutex.Unlock()
+
+	connection.routes = append(connection.routes, route{method: method, uri: uri, handler: handler})
+
+	return connection
+}
+
+// HandlePrefix registers a handler for the requests of a method to every URI that starts with a prefix, the longest
+// matching prefix wins and an exact URI always wins over a prefix
+//
+//	:param method: The HTTP method, for example http.MethodGet
+//	:param prefix: The URI prefix
+//	:param handler: The handler
+func (connection *Connection) HandlePrefix(method string, prefix string, handler Handler) *Connection {
+	connection.mutex.Lock()
+	defer connection.mutex.Unlock()
+
+	connection.routes = append(connection.routes, route{method: method, uri: prefix, prefix: true, handler: handler})
+
+	return connection
+}
+
+// HandleJSON registers a handler that answers the requests of a method to a URI with the same JSON body
+//
+//	:param method: The HTTP method, for example http.MethodGet
+//	:param uri: The URI
+//	:param data: The data to answer with
+func (connection *Connection) HandleJSON(method string, uri string, data any) *Connection {
+	return connection.Handle(method, uri, func(Request) (*http.Response, error) {
+		return JSON(data)
+	})
+}
+
+// Requests gets a copy of the requests made so far
+func (connection *Connection) Requests() []Request {
+	connection.mutex.Lock()
+	defer connection.mutex.Unlock()
+
+	return slices.Clone(connection.requests)
+}
+
+// Calls gets the requests made so far as strings, for example "POST job_templates/7/launch/"
+//
+//	:param methods: The methods to keep, none keeps every method
+func (connection *Connection) Calls(methods ...string) []string {
+	calls := []string{}
+
+	for _, request := range connection.Requests() {
+		if len(methods) == 0 || slices.Contains(methods, request.Method) {
+			calls = append(calls, request.String())
+		}
+	}
+
+	return calls
+}
+
+// Get answers a GET request
+func (connection *Connection) Get(uri string, params map[string]string) (*http.Response, error) {
+	return connection.do(Request{Method: http.MethodGet, URI: uri, Params: params})
+}
+
+// Post answers a POST request
+func (connection *Connection) Post(uri string, data []byte) (*http.Response, error) {
+	return connection.do(Request{Method: http.MethodPost, URI: uri, Data: data})
+}
+
+// Patch answers a PATCH request
+func (connection *Connection) Patch(uri string, data []byte) (*http.Response, error) {
+	return connection.do(Request{Method: http.MethodPatch, URI: uri, Data: data})
+}
+
+// Delete answers a DELETE request
+func (connection *Connection) Delete(uri string, data []byte) (*http.Response, error) {
+	return connection.do(Request{Method: http.MethodDelete, URI: uri, Data: data})
+}
+
+// do records a request and answers it with the handler of the route that matches it, outside of the lock so a
+// handler can make requests itself
+func (connection *Connection) do(request Request) (*http.Response, error) {
+	request.Params = maps.Clone(request.Params)
+
+	connection.mutex.Lock()
+	connection.requests = append(connection.requests, request)
+	handler := connection.match(request)
+	connection.mutex.Unlock()
+
+	if handler == nil {
+		return Status(http.StatusNotFound, map[string]string{"detail": fmt.Sprintf("no route for %s", request)})
+	}
+
+	return handler(request)
+}
+
+// match finds the handler of a request, the caller holds the lock
+func (connection *Connection) match(request Request) Handler {
+	var best *route
+
+	for i, candidate := range connection.routes {
+		if candidate.method != request.Method {
+			continue
+		}
+
+		if !candidate.prefix && candidate.uri == request.URI {
+			return candidate.handler
+		}
+
+		if candidate.prefix && strings.HasPrefix(request.URI, candidate.uri) && (best == nil || len(candidate.uri) > len(best.uri)) {
+			best = &connection.routes[i]
+		}
+	}
+
+	if best != nil {
+		return best.handler
+	}
+
+	return connection.Fallback
+}
+
+// JSON answers with a 200 response whose body is the JSON of data
+//
+//	:param data: The data to answer with
+func JSON(data any) (*http.Response, error) {
+	return Status(http.StatusOK, data)
+}
+
+// Status answers with a response of a status code whose body is the JSON of data
+//
+//	:param statusCode: The status code
+//	:param data: The data to answer with
+func Status(statusCode int, data any) (*http.Response, error) {
+	body, err := json.Marshal(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Response{StatusCode: statusCode, Body: io.NopCloser(bytes.NewReader(body))}, nil
+}
+
+// NoContent answers with an empty 204 response
+func NoContent() (*http.Response, error) {
+	return &http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(bytes.NewReader(nil))}, nil
+}
@@ -0,0 +1,87 @@
+package fakeconnection
+
+import (
+	"io"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+func TestConnection_routes(t *testing.T) {
+	answer := func(body string) Handler {
+		return func(Request) (*http.Response, error) {
+			return JSON(body)
+		}
+	}
+
+	connection := New().
+		HandlePrefix(http.MethodGet, "jobs/", answer("jobs prefix")).
+		HandlePrefix(http.MethodGet, "jobs/5/", answer("job 5 prefix")).
+		Handle(http.MethodGet, "jobs/5/", answer("job 5")).
+		Handle(http.MethodPost, "jobs/5/", answer("post job 5"))
+
+	tests := []struct {
+		method     string
+		uri        string
+		wantStatus int
+		wantBody   string
+	}{
+		{method: http.MethodGet, uri: "jobs/5/", wantStatus: http.StatusOK, wantBody: `"job 5"`},
+		{method: http.MethodGet, uri: "jobs/5/cancel/", wantStatus: http.StatusOK, wantBody: `"job 5 prefix"`},
+		{method: http.MethodGet, uri: "jobs/6/", wantStatus: http.StatusOK, wantBody: `"jobs prefix"`},
+		{method: http.MethodPost, uri: "jobs/5/", wantStatus: http.StatusOK, wantBody: `"post job 5"`},
+		{method: http.MethodDelete, uri: "jobs/5/", wantStatus: http.StatusNotFound, wantBody: `{"detail":"no route for DELETE jobs/5/"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.uri, func(t *testing.T) {
+			var response *http.Response
+			var err error
+
+			switch tt.method {
+			case http.MethodGet:
+				response, err = connection.Get(tt.uri, nil)
+			case http.MethodPost:
+				response, err = connection.Post(tt.uri, nil)
+			default:
+				response, err = connection.Delete(tt.uri, nil)
+			}
+
+			if err != nil {
+				t.Fatalf("Connection.%s() error = %v", tt.method, err)
+			}
+
+			body, _ := io.ReadAll(response.Body)
+
+			if response.StatusCode != tt.wantStatus || string(body) != tt.wantBody {
+				t.Errorf("Connection.%s() = %d %s, want %d %s", tt.method, response.StatusCode, body, tt.wantStatus, tt.wantBody)
+			}
+		})
+	}
+
+	want := []string{"POST jobs/5/", "DELETE jobs/5/"}
+
+	if calls := connection.Calls(http.MethodPost, http.MethodDelete); !slices.Equal(calls, want) {
+		t.Errorf("Connection.Calls() = %v, want %v", calls, want)
+	}
+}
+
+func TestConnection_Requests(t *testing.T) {
+	connection := New()
+	params := map[string]string{"page": "1"}
+
+	_, _ = connection.Get("job_templates/7/", params)
+	params["page"] = "2"
+	_, _ = connection.Post("job_templates/7/launch/", []byte(`{"limit":"core"}`))
+
+	requests := connection.Requests()
+
+	if len(requests) != 2 || requests[0].Params["page"] != "1" || requests[0].ID() != 7 {
+		t.Fatalf("Connection.Requests() = %+v, want the params as they were sent", requests)
+	}
+
+	data := map[string]string{}
+
+	if err := requests[1].Decode(&data); err != nil || data["limit"] != "core" {
+		t.Errorf("Request.Decode() = %v, err = %v", data, err)
+	}
+}
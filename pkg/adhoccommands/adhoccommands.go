@@ -0,0 +1,351 @@
+/*
+Package adhoccommands provides a way to run ad hoc commands against inventories, groups and hosts for Ansible AAP
+*/
+package adhoccommands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/common"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/connection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/dataconversion"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/jobs"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/polling"
+	"io"
+	"strings"
+)
+
+// Target URIs of the resources an ad hoc command can run against
+const (
+	TargetInventory = "inventories/"
+	TargetGroup     = "groups/"
+	TargetHost      = "hosts/"
+)
+
+// AdHocCommand represents an AAP ad hoc command
+type AdHocCommand struct {
+	URI            string
+	connection     connection.BasicConnection
+	DataConversion dataconversion.DataConverterInterface
+}
+
+// NewAdHocCommand creates a new ad hoc command instance
+//
+//	:param basicConnection: The basic connection to use
+func NewAdHocCommand(basicConnection connection.BasicConnection) *AdHocCommand {
+	return &AdHocCommand{
+		URI:            "ad_hoc_commands/",
+		connection:     basicConnection,
+		DataConversion: dataconversion.NewDataConverter(),
+	}
+}
+
+// GetAllAdHocCommands gets all ad hoc commands
+func (adHocCommand *AdHocCommand) GetAllAdHocCommands() (schemaResponse AdHocCommandResponseSchema, err error) {
+	schemaResponse = AdHocCommandResponseSchema{}
+
+	response, err := adHocCommand.connection.Get(adHocCommand.URI, nil)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = adHocCommand.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// GetAdHocCommand gets an ad hoc command by ID
+//
+//	:param id: The ID of the ad hoc command to get
+func (adHocCommand *AdHocCommand) GetAdHocCommand(id int32) (schemaResponse AdHocCommandResponseSingleSchema, err error) {
+	schemaResponse = AdHocCommandResponseSingleSchema{}
+
+	uri := fmt.Sprintf("%s%d/", adHocCommand.URI, id)
+
+	response, err := adHocCommand.connection.Get(uri, nil)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = adHocCommand.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// GetAdHocCommandStatus gets the status of an ad hoc command by ID
+//
+//	:param id: The ID of the ad hoc command to get the status for
+func (adHocCommand *AdHocCommand) GetAdHocCommandStatus(id int32) (status string, err error) {
+	response, err := adHocCommand.GetAdHocCommand(id)
+
+	if err != nil {
+		return "", err
+	}
+
+	if response.Status == "" {
+		return "", fmt.Errorf("status not found for ad hoc command %d", id)
+	}
+
+	return response.Status, nil
+}
+
+// LaunchAdHocCommand launches an ad hoc command against the inventory of the request
+//
+//	:param adHocCommandRequest: The ad hoc command request schema to use
+func (adHocCommand *AdHocCommand) LaunchAdHocCommand(adHocCommandRequest AdHocCommandRequestSchema) (schemaResponse AdHocCommandResponseSingleSchema, err error) {
+	if adHocCommandRequest.Inventory == 0 {
+		return AdHocCommandResponseSingleSchema{}, errors.New("an inventory is required to launch an ad hoc command")
+	}
+
+	return adHocCommand.launch(adHocCommand.URI, adHocCommandRequest)
+}
+
+// LaunchAdHocCommandOn launches an ad hoc command against an inventory, group or host
+//
+//	:param targetURI: The target URI (TargetInventory, TargetGroup, TargetHost)
+//	:param targetID: The ID of the target
+//	:param adHocCommandRequest: The ad hoc command request schema to use
+func (adHocCommand *AdHocCommand) LaunchAdHocCommandOn(targetURI string, targetID int32, adHocCommandRequest AdHocCommandRequestSchema) (schemaResponse AdHocCommandResponseSingleSchema, err error) {
+	uri := fmt.Sprintf("%s%d/%s", targetURI, targetID, adHocCommand.URI)
+
+	return adHocCommand.launch(uri, adHocCommandRequest)
+}
+
+// launch posts an ad hoc command request
+//
+//	:param uri: The URI to post to
+//	:param adHocCommandRequest: The ad hoc command request schema to use
+func (adHocCommand *AdHocCommand) launch(uri string, adHocCommandRequest AdHocCommandRequestSchema) (schemaResponse AdHocCommandResponseSingleSchema, err error) {
+	schemaResponse = AdHocCommandResponseSingleSchema{}
+
+	data, err := json.Marshal(adHocCommandRequest)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	response, err := adHocCommand.connection.Post(uri, data)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = adHocCommand.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// CancelAdHocCommand cancels an ad hoc command by ID
+//
+//	:param id: The ID of the ad hoc command to cancel
+func (adHocCommand *AdHocCommand) CancelAdHocCommand(id int32) (statusCode int, err error) {
+	schemaResponse := AdHocCommandCancelResponseSchema{}
+
+	uri := fmt.Sprintf("%s%d/cancel/", adHocCommand.URI, id)
+
+	response, err := adHocCommand.connection.Get(uri, nil)
+
+	if err != nil {
+		return 0, err
+	}
+
+	err = adHocCommand.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if !schemaResponse.CanCancel {
+		return 0, fmt.Errorf("ad hoc command %d: %w", id, jobs.ErrJobNotCancelable)
+	}
+
+	response, err = adHocCommand.connection.Post(uri, []byte("{}"))
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer response.Body.Close()
+
+	return response.StatusCode, nil
+}
+
+// DeleteAdHocCommand deletes an ad hoc command by ID
+//
+//	:param id: The ID of the ad hoc command to delete
+func (adHocCommand *AdHocCommand) DeleteAdHocCommand(id int32) (statusCode int, err error) {
+	uri := fmt.Sprintf("%s%d/", adHocCommand.URI, id)
+
+	response, err := adHocCommand.connection.Delete(uri, nil)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response.StatusCode, nil
+}
+
+// GetAdHocCommandStdOut gets the standard output of an ad hoc command by ID
+//
+//	:param id: The ID of the ad hoc command to get the standard output for
+//	:param outputFormat: The format to get the output in ("txt", "ansi", "json", "html")
+func (adHocCommand *AdHocCommand) GetAdHocCommandStdOut(id int32, outputFormat string) (response string, err error) {
+	params := map[string]string{
+		"format": outputFormat,
+	}
+
+	uri := fmt.Sprintf("%s%d/stdout/", adHocCommand.URI, id)
+
+	resp, err := adHocCommand.connection.Get(uri, params)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(body), nil
+}
+
+// WaitForAdHocCommand polls an ad hoc command until it reaches a finished status
+//
+//	:param ctx: The context used to stop waiting
+//	:param id: The ID of the ad hoc command to wait for
+//	:param options: The poll options, the interval must be positive
+func (adHocCommand *AdHocCommand) WaitForAdHocCommand(ctx context.Context, id int32, options polling.Options) (status string, err error) {
+	watch := polling.Watch{Label: "Ad Hoc Command", ID: id}
+
+	return polling.WaitForStatus(ctx, options, watch, func() (string, error) {
+		return adHocCommand.GetAdHocCommandStatus(id)
+	})
+}
+
+// RunAndWait launches an ad hoc command against an inventory, group or host and waits for it to finish
+//
+//	:param ctx: The context used to stop waiting
+//	:param targetURI: The target URI (TargetInventory, TargetGroup, TargetHost)
+//	:param targetID: The ID of the target
+//	:param adHocCommandRequest: The ad hoc command request schema to use
+//	:param options: The poll options, the interval must be positive
+func (adHocCommand *AdHocCommand) RunAndWait(ctx context.Context, targetURI string, targetID int32, adHocCommandRequest AdHocCommandRequestSchema, options polling.Options) (schemaResponse AdHocCommandResponseSingleSchema, err error) {
+	schemaResponse, err = adHocCommand.LaunchAdHocCommandOn(targetURI, targetID, adHocCommandRequest)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	_, err = adHocCommand.WaitForAdHocCommand(ctx, schemaResponse.ID, options)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return adHocCommand.GetAdHocCommand(schemaResponse.ID)
+}
+
+// GetAdHocCommandEvents gets every event of an ad hoc command, following the pages of the response
+//
+//	:param id: The ID of the ad hoc command
+//	:param events: The event types to return, for example runner_on_failed, none returns every event
+func (adHocCommand *AdHocCommand) GetAdHocCommandEvents(id int32, events ...string) (schemaEvents []AdHocCommandEventResponseSingleSchema, err error) {
+	params := map[string]string{
+		"order_by": "counter",
+	}
+
+	switch len(events) {
+	case 0:
+	case 1:
+		params["event"] = events[0]
+	default:
+		params["event__in"] = strings.Join(events, ",")
+	}
+
+	uri := fmt.Sprintf("%s%d/events/", adHocCommand.URI, id)
+
+	return common.GetAllPages[AdHocCommandEventResponseSingleSchema](adHocCommand.connection, adHocCommand.DataConversion, uri, params)
+}
+
+// GetHostResults gets the result of an ad hoc command on each host, in the order the hosts finished
+//
+//	:param id: The ID of the ad hoc command
+func (adHocCommand *AdHocCommand) GetHostResults(id int32) (hostResults []HostResultSchema, err error) {
+	events, err := adHocCommand.GetAdHocCommandEvents(id, jobs.EventRunnerOnOk, jobs.EventRunnerOnFailed, jobs.EventRunnerOnUnreachable, jobs.EventRunnerOnSkipped)
+
+	if err != nil {
+		return []HostResultSchema{}, err
+	}
+
+	return hostResultsFromEvents(events)
+}
+
+// hostResultsFromEvents converts the runner events of an ad hoc command to host results
+//
+//	:param events: The runner events
+func hostResultsFromEvents(events []AdHocCommandEventResponseSingleSchema) (hostResults []HostResultSchema, err error) {
+	hostResults = []HostResultSchema{}
+
+	for _, event := range events {
+		eventData := jobs.RunnerOnOkEventDataSchema{}
+
+		if len(event.EventData) > 0 {
+			if err = json.Unmarshal(event.EventData, &eventData); err != nil {
+				return hostResults, fmt.Errorf("error decoding event data of %s event %d: %w", event.Event, event.ID, err)
+			}
+		}
+
+		hostResult := HostResultSchema{
+			HostName:    event.HostName,
+			Event:       event.Event,
+			Failed:      event.Failed,
+			Unreachable: event.Event == jobs.EventRunnerOnUnreachable,
+			Changed:     event.Changed,
+			Rc:          eventData.Res.Rc,
+			Message:     eventData.Res.Message(),
+		}
+
+		if hostResult.HostName == "" {
+			hostResult.HostName = eventData.Host
+		}
+
+		switch stdout := eventData.Res.Stdout.(type) {
+		case string:
+			hostResult.Output = stdout
+		case []any:
+			lines := make([]string, 0, len(stdout))
+
+			for _, line := range stdout {
+				lines = append(lines, fmt.Sprint(line))
+			}
+
+			hostResult.Output = strings.Join(lines, "\n")
+		default:
+			hostResult.Output = strings.TrimSpace(event.StdOut)
+		}
+
+		hostResults = append(hostResults, hostResult)
+	}
+
+	return hostResults, nil
+}
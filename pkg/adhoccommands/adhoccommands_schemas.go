@@ -0,0 +1,108 @@
+package adhoccommands
+
+import "encoding/json"
+
+// AdHocCommandRequestSchema is the schema for an ad hoc command request
+type AdHocCommandRequestSchema struct {
+	JobType       string `json:"job_type,omitempty" yaml:"job_type,omitempty"`
+	Inventory     int32  `json:"inventory,omitempty" yaml:"inventory,omitempty"`
+	Limit         string `json:"limit" yaml:"limit"`
+	Credential    int32  `json:"credential,omitempty" yaml:"credential,omitempty"`
+	ModuleName    string `json:"module_name" yaml:"module_name"`
+	ModuleArgs    string `json:"module_args" yaml:"module_args"`
+	Forks         int32  `json:"forks" yaml:"forks"`
+	Verbosity     int32  `json:"verbosity" yaml:"verbosity"`
+	ExtraVars     string `json:"extra_vars,omitempty" yaml:"extra_vars,omitempty"`
+	BecomeEnabled bool   `json:"become_enabled" yaml:"become_enabled"`
+	DiffMode      bool   `json:"diff_mode" yaml:"diff_mode"`
+}
+
+// AdHocCommandRelatedResponseSchema is the schema for the related section of a response
+type AdHocCommandRelatedResponseSchema struct {
+	CreatedBy      string `json:"created_by" yaml:"created_by"`
+	ModifiedBy     string `json:"modified_by" yaml:"modified_by"`
+	Inventory      string `json:"inventory" yaml:"inventory"`
+	Credential     string `json:"credential" yaml:"credential"`
+	Events         string `json:"events" yaml:"events"`
+	ActivityStream string `json:"activity_stream" yaml:"activity_stream"`
+	Notifications  string `json:"notifications" yaml:"notifications"`
+	Cancel         string `json:"cancel" yaml:"cancel"`
+	Relaunch       string `json:"relaunch" yaml:"relaunch"`
+	Stdout         string `json:"stdout" yaml:"stdout"`
+}
+
+// AdHocCommandResponseSingleSchema is the schema for a single ad hoc command response item
+type AdHocCommandResponseSingleSchema struct {
+	ID      int32                             `json:"id" yaml:"id"`
+	Type    string                            `json:"type" yaml:"type"`
+	URL     string                            `json:"url" yaml:"url"`
+	Related AdHocCommandRelatedResponseSchema `json:"related" yaml:"related"`
+	AdHocCommandRequestSchema
+	Created        string  `json:"created" yaml:"created"`
+	Modified       string  `json:"modified" yaml:"modified"`
+	Name           string  `json:"name" yaml:"name"`
+	LaunchType     string  `json:"launch_type" yaml:"launch_type"`
+	Status         string  `json:"status" yaml:"status"`
+	Failed         bool    `json:"failed" yaml:"failed"`
+	Started        string  `json:"started" yaml:"started"`
+	Finished       string  `json:"finished" yaml:"finished"`
+	CanceledOn     string  `json:"canceled_on" yaml:"canceled_on"`
+	Elapsed        float32 `json:"elapsed" yaml:"elapsed"`
+	JobExplanation string  `json:"job_explanation" yaml:"job_explanation"`
+	ExecutionNode  string  `json:"execution_node" yaml:"execution_node"`
+}
+
+// AdHocCommandResponseSchema is the schema for an ad hoc command response
+type AdHocCommandResponseSchema struct {
+	Count    int32                              `json:"count" yaml:"count"`
+	Next     string                             `json:"next" yaml:"next"`
+	Previous string                             `json:"previous" yaml:"previous"`
+	Results  []AdHocCommandResponseSingleSchema `json:"results" yaml:"results"`
+}
+
+// AdHocCommandCancelResponseSchema is the schema for the response of a GET of the cancel endpoint
+type AdHocCommandCancelResponseSchema struct {
+	CanCancel bool `json:"can_cancel" yaml:"can_cancel"`
+}
+
+// AdHocCommandEventResponseSingleSchema is the schema for a single ad hoc command event response item
+type AdHocCommandEventResponseSingleSchema struct {
+	ID           int32           `json:"id" yaml:"id"`
+	Type         string          `json:"type" yaml:"type"`
+	URL          string          `json:"url" yaml:"url"`
+	Created      string          `json:"created" yaml:"created"`
+	Modified     string          `json:"modified" yaml:"modified"`
+	AdHocCommand int32           `json:"ad_hoc_command" yaml:"ad_hoc_command"`
+	Event        string          `json:"event" yaml:"event"`
+	Counter      int32           `json:"counter" yaml:"counter"`
+	EventData    json.RawMessage `json:"event_data" yaml:"event_data"`
+	Failed       bool            `json:"failed" yaml:"failed"`
+	Changed      bool            `json:"changed" yaml:"changed"`
+	UUID         string          `json:"uuid" yaml:"uuid"`
+	Host         int32           `json:"host" yaml:"host"`
+	HostName     string          `json:"host_name" yaml:"host_name"`
+	StdOut       string          `json:"stdout" yaml:"stdout"`
+	StartLine    int32           `json:"start_line" yaml:"start_line"`
+	EndLine      int32           `json:"end_line" yaml:"end_line"`
+	Verbosity    int32           `json:"verbosity" yaml:"verbosity"`
+}
+
+// AdHocCommandEventResponseSchema is the schema for an ad hoc command event response
+type AdHocCommandEventResponseSchema struct {
+	Count    int32                                   `json:"count" yaml:"count"`
+	Next     string                                  `json:"next" yaml:"next"`
+	Previous string                                  `json:"previous" yaml:"previous"`
+	Results  []AdHocCommandEventResponseSingleSchema `json:"results" yaml:"results"`
+}
+
+// HostResultSchema is the schema for the result of an ad hoc command on one host
+type HostResultSchema struct {
+	HostName    string `json:"host_name" yaml:"host_name"`
+	Event       string `json:"event" yaml:"event"`
+	Failed      bool   `json:"failed" yaml:"failed"`
+	Unreachable bool   `json:"unreachable" yaml:"unreachable"`
+	Changed     bool   `json:"changed" yaml:"changed"`
+	Rc          *int32 `json:"rc" yaml:"rc"`
+	Output      string `json:"output" yaml:"output"`
+	Message     string `json:"message" yaml:"message"`
+}
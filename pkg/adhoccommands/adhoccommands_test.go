@@ -0,0 +1,115 @@
+package adhoccommands
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/btr1975/go-ansible-aap-api-client/internal/fakeconnection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/jobs"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+func TestAdHocCommand_LaunchAdHocCommandOn(t *testing.T) {
+	connection := fakeconnection.New().HandleJSON(http.MethodPost, "groups/4/ad_hoc_commands/", AdHocCommandResponseSingleSchema{ID: 12, Status: "pending"})
+	adHocCommand := NewAdHocCommand(connection)
+
+	response, err := adHocCommand.LaunchAdHocCommandOn(TargetGroup, 4, AdHocCommandRequestSchema{ModuleName: "ansible.netcommon.cli_command", ModuleArgs: "command='show version'"})
+
+	if err != nil || response.ID != 12 {
+		t.Fatalf("AdHocCommand.LaunchAdHocCommandOn() = %+v, err = %v", response, err)
+	}
+
+	if calls := connection.Calls(); !slices.Equal(calls, []string{"POST groups/4/ad_hoc_commands/"}) {
+		t.Errorf("AdHocCommand.LaunchAdHocCommandOn() requests = %v, want [POST groups/4/ad_hoc_commands/]", calls)
+	}
+
+	if _, err = adHocCommand.LaunchAdHocCommand(AdHocCommandRequestSchema{ModuleName: "ping"}); err == nil {
+		t.Errorf("AdHocCommand.LaunchAdHocCommand() error = nil, want missing inventory error")
+	}
+}
+
+func TestAdHocCommand_GetHostResults(t *testing.T) {
+	events := []AdHocCommandEventResponseSingleSchema{
+		{ID: 1, Event: "runner_on_ok", HostName: "r1", EventData: json.RawMessage(`{"host":"r1","res":{"rc":0,"stdout":"Cisco IOS XE 17.9"}}`)},
+		{ID: 2, Event: "runner_on_ok", HostName: "r2", EventData: json.RawMessage(`{"host":"r2","res":{"stdout":["NX-OS 10.2","uptime 3 days"]}}`)},
+		{ID: 3, Event: "runner_on_failed", HostName: "r3", Failed: true, EventData: json.RawMessage(`{"host":"r3","res":{"rc":1,"msg":"command timeout"}}`)},
+		{ID: 4, Event: "runner_on_unreachable", Failed: true, StdOut: "r4 | UNREACHABLE! ", EventData: json.RawMessage(`{"host":"r4","res":{"msg":"ssh timeout"}}`)},
+	}
+	connection := fakeconnection.New().HandleJSON(http.MethodGet, "ad_hoc_commands/12/events/", AdHocCommandEventResponseSchema{Count: int32(len(events)), Results: events})
+	adHocCommand := NewAdHocCommand(connection)
+
+	hostResults, err := adHocCommand.GetHostResults(12)
+
+	if err != nil {
+		t.Fatalf("AdHocCommand.GetHostResults() error = %v", err)
+	}
+
+	request := connection.Requests()[0]
+
+	if request.Params["event__in"] != "runner_on_ok,runner_on_failed,runner_on_unreachable,runner_on_skipped" {
+		t.Errorf("AdHocCommand.GetHostResults() request = %s, params = %v", request, request.Params)
+	}
+
+	want := []HostResultSchema{
+		{HostName: "r1", Event: "runner_on_ok", Output: "Cisco IOS XE 17.9"},
+		{HostName: "r2", Event: "runner_on_ok", Output: "NX-OS 10.2\nuptime 3 days"},
+		{HostName: "r3", Event: "runner_on_failed", Failed: true, Message: "command timeout"},
+		{HostName: "r4", Event: "runner_on_unreachable", Failed: true, Unreachable: true, Output: "r4 | UNREACHABLE!", Message: "ssh timeout"},
+	}
+
+	if len(hostResults) != len(want) {
+		t.Fatalf("AdHocCommand.GetHostResults() = %+v, want %+v", hostResults, want)
+	}
+
+	for i := range want {
+		got := hostResults[i]
+		got.Rc = nil
+
+		if got != want[i] {
+			t.Errorf("AdHocCommand.GetHostResults()[%d] = %+v, want %+v", i, got, want[i])
+		}
+	}
+
+	if hostResults[2].Rc == nil || *hostResults[2].Rc != 1 {
+		t.Errorf("AdHocCommand.GetHostResults()[2].Rc = %v, want 1", hostResults[2].Rc)
+	}
+}
+
+func TestAdHocCommand_CancelAdHocCommand(t *testing.T) {
+	tests := []struct {
+		name      string
+		canCancel bool
+		wantPosts []string
+		wantErr   error
+	}{
+		{
+			name:      "Test CancelAdHocCommand running",
+			canCancel: true,
+			wantPosts: []string{"POST ad_hoc_commands/12/cancel/"},
+		},
+		{
+			name:      "Test CancelAdHocCommand finished",
+			canCancel: false,
+			wantPosts: []string{},
+			wantErr:   jobs.ErrJobNotCancelable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			connection := fakeconnection.New().
+				HandleJSON(http.MethodGet, "ad_hoc_commands/12/cancel/", AdHocCommandCancelResponseSchema{CanCancel: tt.canCancel}).
+				HandleJSON(http.MethodPost, "ad_hoc_commands/12/cancel/", map[string]any{})
+
+			_, err := NewAdHocCommand(connection).CancelAdHocCommand(12)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("AdHocCommand.CancelAdHocCommand() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if posts := connection.Calls(http.MethodPost); !slices.Equal(posts, tt.wantPosts) {
+				t.Errorf("AdHocCommand.CancelAdHocCommand() posts = %v, want %v", posts, tt.wantPosts)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Package common provides the paging and association helpers shared by the Ansible AAP packages
+*/
+package common
+
+import (
+	"encoding/json"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/connection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/dataconversion"
+	"strconv"
+)
+
+// GetAllPages gets every item of a list endpoint, following the pages of the response
+//
+// The items are ordered by id with 200 items a page unless the params set order_by or page_size.
+//
+//	:param basicConnection: The basic connection to use
+//	:param dataConversion: The data converter to use
+//	:param uri: The URI of the list endpoint
+//	:param params: The query params to filter with
+func GetAllPages[T any](basicConnection connection.BasicConnection, dataConversion dataconversion.DataConverterInterface, uri string, params map[string]string) (items []T, err error) {
+	items = []T{}
+
+	pageParams := map[string]string{
+		"order_by":  "id",
+		"page_size": "200",
+	}
+
+	for key, value := range params {
+		pageParams[key] = value
+	}
+
+	for page := 1; ; page++ {
+		pageParams["page"] = strconv.Itoa(page)
+
+		response, err := basicConnection.Get(uri, pageParams)
+
+		if err != nil {
+			return items, err
+		}
+
+		schemaResponse := PageSchema[T]{}
+
+		err = dataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+		if err != nil {
+			return items, err
+		}
+
+		items = append(items, schemaResponse.Results...)
+
+		if schemaResponse.Next == "" || len(schemaResponse.Results) == 0 {
+			return items, nil
+		}
+	}
+}
+
+// Associate associates or disassociates an object on a related endpoint
+//
+//	:param basicConnection: The basic connection to use
+//	:param uri: The URI of the related endpoint
+//	:param id: The ID of the object
+//	:param disassociate: Whether to disassociate instead of associate
+func Associate(basicConnection connection.BasicConnection, uri string, id int32, disassociate bool) (statusCode int, err error) {
+	data, err := json.Marshal(AssociationRequestSchema{ID: id, Disassociate: disassociate})
+
+	if err != nil {
+		return 0, err
+	}
+
+	response, err := basicConnection.Post(uri, data)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer response.Body.Close()
+
+	return response.StatusCode, nil
+}
@@ -0,0 +1,15 @@
+package common
+
+// PageSchema is the schema for one page of a list response
+type PageSchema[T any] struct {
+	Count    int32  `json:"count" yaml:"count"`
+	Next     string `json:"next" yaml:"next"`
+	Previous string `json:"previous" yaml:"previous"`
+	Results  []T    `json:"results" yaml:"results"`
+}
+
+// AssociationRequestSchema is the schema for associating or disassociating a related object
+type AssociationRequestSchema struct {
+	ID           int32 `json:"id" yaml:"id"`
+	Disassociate bool  `json:"disassociate,omitempty" yaml:"disassociate,omitempty"`
+}
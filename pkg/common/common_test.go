@@ -0,0 +1,79 @@
+package common
+
+import (
+	"github.com/btr1975/go-ansible-aap-api-client/internal/fakeconnection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/dataconversion"
+	"maps"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+type testItem struct {
+	ID int32 `json:"id"`
+}
+
+// newFakePageConnection answers three pages of one item each
+func newFakePageConnection() *fakeconnection.Connection {
+	pages := 0
+
+	return fakeconnection.New().Handle(http.MethodGet, "things/", func(fakeconnection.Request) (*http.Response, error) {
+		pages++
+		page := PageSchema[testItem]{Count: 3, Results: []testItem{{ID: int32(pages)}}}
+
+		if pages < 3 {
+			page.Next = "next"
+		}
+
+		return fakeconnection.JSON(page)
+	})
+}
+
+func TestGetAllPages(t *testing.T) {
+	connection := newFakePageConnection()
+
+	items, err := GetAllPages[testItem](connection, dataconversion.NewDataConverter(), "things/", map[string]string{"order_by": "name", "name": "core"})
+
+	if err != nil {
+		t.Fatalf("GetAllPages() error = %v", err)
+	}
+
+	if !slices.Equal(items, []testItem{{ID: 1}, {ID: 2}, {ID: 3}}) {
+		t.Errorf("GetAllPages() = %v, want the item of each page", items)
+	}
+
+	want := []map[string]string{
+		{"order_by": "name", "page_size": "200", "page": "1", "name": "core"},
+		{"order_by": "name", "page_size": "200", "page": "2", "name": "core"},
+		{"order_by": "name", "page_size": "200", "page": "3", "name": "core"},
+	}
+
+	params := []map[string]string{}
+
+	for _, request := range connection.Requests() {
+		params = append(params, request.Params)
+	}
+
+	if !slices.EqualFunc(params, want, maps.Equal) {
+		t.Errorf("GetAllPages() params = %v, want %v", params, want)
+	}
+}
+
+func TestAssociate(t *testing.T) {
+	connection := fakeconnection.New().Handle(http.MethodPost, "teams/8/users/", func(fakeconnection.Request) (*http.Response, error) {
+		return fakeconnection.NoContent()
+	})
+
+	statusCode, err := Associate(connection, "teams/8/users/", 11, true)
+
+	if err != nil || statusCode != 204 {
+		t.Fatalf("Associate() = %d, %v", statusCode, err)
+	}
+
+	got := AssociationRequestSchema{}
+	_ = connection.Requests()[0].Decode(&got)
+
+	if got != (AssociationRequestSchema{ID: 11, Disassociate: true}) {
+		t.Errorf("Associate() posted %+v", got)
+	}
+}
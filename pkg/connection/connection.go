@@ -109,6 +109,15 @@ func (connection *Connection) createTLSConfig(sslVerify bool, certPath string) (
 	return tlsConfig, nil
 }
 
+// TLSConfig gets the TLS config used by the connection, so other clients such as the websocket client can share it
+func (connection *Connection) TLSConfig() *tls.Config {
+	if connection.transport == nil {
+		return nil
+	}
+
+	return connection.transport.TLSClientConfig
+}
+
 // createRequest creates a new request
 //
 //	:param method: The HTTP method to use
@@ -170,16 +179,16 @@ func (connection *Connection) Get(uri string, params map[string]string) (respons
 		Timeout:   time.Second * 10,
 	}
 
+	finalURL := connection.BaseURL.JoinPath(connection.APIVersion, uri)
+
 	if params != nil {
-		q := connection.BaseURL.Query()
+		q := finalURL.Query()
 		for key, value := range params {
 			q.Set(key, value)
 		}
-		connection.BaseURL.RawQuery = q.Encode()
+		finalURL.RawQuery = q.Encode()
 	}
 
-	finalURL := connection.BaseURL.JoinPath(connection.APIVersion, uri)
-
 	request, err := connection.createRequest("GET", finalURL.String(), nil)
 
 	if err != nil {
@@ -0,0 +1,314 @@
+/*
+Package credentials provides a way to manipulate credentials and credential types for Ansible AAP
+
+Secret inputs are kept in CredentialInputs and SecretString values, both of which are redacted
+when printed with the fmt package, so a credential request can be logged without leaking secrets.
+*/
+package credentials
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/common"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/connection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/dataconversion"
+)
+
+// Names of the credential types managed by AAP
+const (
+	CredentialTypeMachine       = "Machine"
+	CredentialTypeNetwork       = "Network"
+	CredentialTypeSourceControl = "Source Control"
+	CredentialTypeVault         = "Vault"
+)
+
+// Credential represents an AAP credential
+type Credential struct {
+	URI            string
+	JobTemplateURI string
+	ProjectURI     string
+	connection     connection.BasicConnection
+	DataConversion dataconversion.DataConverterInterface
+}
+
+// NewCredential creates a new credential instance
+//
+//	:param basicConnection: The basic connection to use
+func NewCredential(basicConnection connection.BasicConnection) *Credential {
+	return &Credential{
+		URI:            "credentials/",
+		JobTemplateURI: "job_templates/",
+		ProjectURI:     "projects/",
+		connection:     basicConnection,
+		DataConversion: dataconversion.NewDataConverter(),
+	}
+}
+
+// ToCredentialInputs converts a typed inputs struct to credential inputs
+//
+//	:param typedInputs: The typed inputs to convert, for example MachineCredentialInputs
+func ToCredentialInputs(typedInputs any) (inputs CredentialInputs, err error) {
+	inputs = CredentialInputs{}
+
+	data, err := json.Marshal(typedInputs)
+
+	if err != nil {
+		return inputs, err
+	}
+
+	err = json.Unmarshal(data, &inputs)
+
+	if err != nil {
+		return inputs, err
+	}
+
+	return inputs, nil
+}
+
+// GetAllCredentials gets all credentials
+func (credential *Credential) GetAllCredentials() (schemaResponse CredentialResponseSchema, err error) {
+	schemaResponse = CredentialResponseSchema{}
+
+	response, err := credential.connection.Get(credential.URI, nil)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = credential.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// GetCredential gets a credential by name
+//
+//	:param name: The name of the credential to get
+func (credential *Credential) GetCredential(name string) (schemaResponse CredentialResponseSchema, err error) {
+	schemaResponse = CredentialResponseSchema{}
+
+	params := map[string]string{
+		"name": name,
+	}
+
+	response, err := credential.connection.Get(credential.URI, params)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = credential.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// GetCredentialID gets a credential ID by name
+//
+//	:param name: The name of the credential to get
+func (credential *Credential) GetCredentialID(name string) (id int32, err error) {
+	schemaResponse, err := credential.GetCredential(name)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if len(schemaResponse.Results) > 1 {
+		return 0, fmt.Errorf("more than one credential found with name %s", name)
+	}
+
+	if len(schemaResponse.Results) == 0 {
+		return 0, fmt.Errorf("no credential found with name %s", name)
+	}
+
+	return schemaResponse.Results[0].ID, nil
+}
+
+// CreateCredential creates a new credential
+//
+//	:param credentialRequest: The credential request schema to use
+func (credential *Credential) CreateCredential(credentialRequest CredentialRequestSchema) (schemaResponse CredentialResponseSingleSchema, err error) {
+	schemaResponse = CredentialResponseSingleSchema{}
+
+	data, err := json.Marshal(credentialRequest)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	response, err := credential.connection.Post(credential.URI, data)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = credential.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// UpdateCredential updates a credential by ID
+//
+//	:param id: The ID of the credential to update
+//	:param credentialRequest: The credential request schema to use
+func (credential *Credential) UpdateCredential(id int32, credentialRequest CredentialRequestSchema) (schemaResponse CredentialResponseSingleSchema, err error) {
+	schemaResponse = CredentialResponseSingleSchema{}
+
+	uri := fmt.Sprintf("%s%d/", credential.URI, id)
+
+	data, err := json.Marshal(credentialRequest)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	response, err := credential.connection.Patch(uri, data)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = credential.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// CopyCredential copies a credential by ID
+//
+//	:param id: The ID of the credential to copy
+//	:param name: The name of the new credential
+func (credential *Credential) CopyCredential(id int32, name string) (schemaResponse CredentialResponseSingleSchema, err error) {
+	schemaResponse = CredentialResponseSingleSchema{}
+
+	uri := fmt.Sprintf("%s%d/copy/", credential.URI, id)
+
+	data, err := json.Marshal(CredentialCopyRequestSchema{Name: name})
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	response, err := credential.connection.Post(uri, data)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = credential.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// DeleteCredential deletes a credential by ID
+//
+//	:param id: The ID of the credential to delete
+func (credential *Credential) DeleteCredential(id int32) (statusCode int, err error) {
+	uri := fmt.Sprintf("%s%d/", credential.URI, id)
+
+	response, err := credential.connection.Delete(uri, nil)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response.StatusCode, nil
+}
+
+// TestCredential tests the external lookup of an existing credential by ID
+//
+//	:param id: The ID of the credential to test
+//	:param metadata: The lookup metadata to test with, for example the secret path
+func (credential *Credential) TestCredential(id int32, metadata map[string]string) (statusCode int, err error) {
+	uri := fmt.Sprintf("%s%d/test/", credential.URI, id)
+
+	data, err := json.Marshal(CredentialTestRequestSchema{Metadata: metadata})
+
+	if err != nil {
+		return 0, err
+	}
+
+	response, err := credential.connection.Post(uri, data)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer response.Body.Close()
+
+	return response.StatusCode, nil
+}
+
+// AttachToJobTemplate attaches a credential to a job template
+//
+//	:param id: The ID of the credential to attach
+//	:param jobTemplateID: The ID of the job template to attach the credential to
+func (credential *Credential) AttachToJobTemplate(id int32, jobTemplateID int32) (statusCode int, err error) {
+	return common.Associate(credential.connection, fmt.Sprintf("%s%d/credentials/", credential.JobTemplateURI, jobTemplateID), id, false)
+}
+
+// DetachFromJobTemplate detaches a credential from a job template
+//
+//	:param id: The ID of the credential to detach
+//	:param jobTemplateID: The ID of the job template to detach the credential from
+func (credential *Credential) DetachFromJobTemplate(id int32, jobTemplateID int32) (statusCode int, err error) {
+	return common.Associate(credential.connection, fmt.Sprintf("%s%d/credentials/", credential.JobTemplateURI, jobTemplateID), id, true)
+}
+
+// AttachToProject sets a credential as the source control credential of a project
+//
+//	:param id: The ID of the credential to attach
+//	:param projectID: The ID of the project to attach the credential to
+func (credential *Credential) AttachToProject(id int32, projectID int32) (statusCode int, err error) {
+	uri := fmt.Sprintf("%s%d/", credential.ProjectURI, projectID)
+
+	data, err := json.Marshal(map[string]int32{"credential": id})
+
+	if err != nil {
+		return 0, err
+	}
+
+	response, err := credential.connection.Patch(uri, data)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer response.Body.Close()
+
+	return response.StatusCode, nil
+}
+
+// DetachFromProject removes the source control credential of a project
+//
+//	:param projectID: The ID of the project to detach the credential from
+func (credential *Credential) DetachFromProject(projectID int32) (statusCode int, err error) {
+	uri := fmt.Sprintf("%s%d/", credential.ProjectURI, projectID)
+
+	response, err := credential.connection.Patch(uri, []byte(`{"credential": null}`))
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer response.Body.Close()
+
+	return response.StatusCode, nil
+}
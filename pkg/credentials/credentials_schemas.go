@@ -0,0 +1,156 @@
+package credentials
+
+// CredentialRequestSchema is the schema for a credential request
+type CredentialRequestSchema struct {
+	Name           string           `json:"name" yaml:"name"`
+	Description    string           `json:"description" yaml:"description"`
+	Organization   int32            `json:"organization,omitempty" yaml:"organization,omitempty"`
+	CredentialType int32            `json:"credential_type" yaml:"credential_type"`
+	Inputs         CredentialInputs `json:"inputs" yaml:"inputs"`
+	User           int32            `json:"user,omitempty" yaml:"user,omitempty"`
+	Team           int32            `json:"team,omitempty" yaml:"team,omitempty"`
+}
+
+// CredentialRelatedResponseSchema is the schema for the related section of a response
+type CredentialRelatedResponseSchema struct {
+	CreatedBy      string `json:"created_by" yaml:"created_by"`
+	ModifiedBy     string `json:"modified_by" yaml:"modified_by"`
+	Organization   string `json:"organization" yaml:"organization"`
+	ActivityStream string `json:"activity_stream" yaml:"activity_stream"`
+	AccessList     string `json:"access_list" yaml:"access_list"`
+	ObjectRoles    string `json:"object_roles" yaml:"object_roles"`
+	OwnerUsers     string `json:"owner_users" yaml:"owner_users"`
+	OwnerTeams     string `json:"owner_teams" yaml:"owner_teams"`
+	Copy           string `json:"copy" yaml:"copy"`
+	InputSources   string `json:"input_sources" yaml:"input_sources"`
+	CredentialType string `json:"credential_type" yaml:"credential_type"`
+	Test           string `json:"test" yaml:"test"`
+}
+
+// CredentialResponseSingleSchema is the schema for a single credential response item
+type CredentialResponseSingleSchema struct {
+	ID      int32                           `json:"id" yaml:"id"`
+	Type    string                          `json:"type" yaml:"type"`
+	URL     string                          `json:"url" yaml:"url"`
+	Related CredentialRelatedResponseSchema `json:"related" yaml:"related"`
+	CredentialRequestSchema
+	Created    string `json:"created" yaml:"created"`
+	Modified   string `json:"modified" yaml:"modified"`
+	Managed    bool   `json:"managed" yaml:"managed"`
+	Kind       string `json:"kind" yaml:"kind"`
+	Cloud      bool   `json:"cloud" yaml:"cloud"`
+	Kubernetes bool   `json:"kubernetes" yaml:"kubernetes"`
+}
+
+// CredentialResponseSchema is the schema for a credential response
+type CredentialResponseSchema struct {
+	Count    int32                            `json:"count" yaml:"count"`
+	Next     string                           `json:"next" yaml:"next"`
+	Previous string                           `json:"previous" yaml:"previous"`
+	Results  []CredentialResponseSingleSchema `json:"results" yaml:"results"`
+}
+
+// CredentialCopyRequestSchema is the schema for a credential copy request
+type CredentialCopyRequestSchema struct {
+	Name string `json:"name" yaml:"name"`
+}
+
+// CredentialTestRequestSchema is the schema for testing an external credential lookup
+type CredentialTestRequestSchema struct {
+	Inputs   CredentialInputs  `json:"inputs,omitempty" yaml:"inputs,omitempty"`
+	Metadata map[string]string `json:"metadata" yaml:"metadata"`
+}
+
+// CredentialTypeFieldSchema is the schema for a single input field of a credential type
+type CredentialTypeFieldSchema struct {
+	ID           string   `json:"id" yaml:"id"`
+	Label        string   `json:"label" yaml:"label"`
+	Type         string   `json:"type,omitempty" yaml:"type,omitempty"`
+	Secret       bool     `json:"secret,omitempty" yaml:"secret,omitempty"`
+	Multiline    bool     `json:"multiline,omitempty" yaml:"multiline,omitempty"`
+	HelpText     string   `json:"help_text,omitempty" yaml:"help_text,omitempty"`
+	Format       string   `json:"format,omitempty" yaml:"format,omitempty"`
+	Choices      []string `json:"choices,omitempty" yaml:"choices,omitempty"`
+	AskAtRuntime bool     `json:"ask_at_runtime,omitempty" yaml:"ask_at_runtime,omitempty"`
+}
+
+// CredentialTypeInputsSchema is the schema for the inputs of a credential type
+type CredentialTypeInputsSchema struct {
+	Fields   []CredentialTypeFieldSchema `json:"fields" yaml:"fields"`
+	Required []string                    `json:"required,omitempty" yaml:"required,omitempty"`
+}
+
+// CredentialTypeInjectorsSchema is the schema for the injectors of a credential type
+type CredentialTypeInjectorsSchema struct {
+	Env       map[string]string `json:"env,omitempty" yaml:"env,omitempty"`
+	ExtraVars map[string]string `json:"extra_vars,omitempty" yaml:"extra_vars,omitempty"`
+	File      map[string]string `json:"file,omitempty" yaml:"file,omitempty"`
+}
+
+// CredentialTypeRequestSchema is the schema for a credential type request
+type CredentialTypeRequestSchema struct {
+	Name        string                        `json:"name" yaml:"name"`
+	Description string                        `json:"description" yaml:"description"`
+	Kind        string                        `json:"kind" yaml:"kind"`
+	Inputs      CredentialTypeInputsSchema    `json:"inputs" yaml:"inputs"`
+	Injectors   CredentialTypeInjectorsSchema `json:"injectors" yaml:"injectors"`
+}
+
+// CredentialTypeRelatedResponseSchema is the schema for the related section of a credential type response
+type CredentialTypeRelatedResponseSchema struct {
+	CreatedBy      string `json:"created_by" yaml:"created_by"`
+	ModifiedBy     string `json:"modified_by" yaml:"modified_by"`
+	Credentials    string `json:"credentials" yaml:"credentials"`
+	ActivityStream string `json:"activity_stream" yaml:"activity_stream"`
+	Test           string `json:"test" yaml:"test"`
+}
+
+// CredentialTypeResponseSingleSchema is the schema for a single credential type response item
+type CredentialTypeResponseSingleSchema struct {
+	ID      int32                               `json:"id" yaml:"id"`
+	Type    string                              `json:"type" yaml:"type"`
+	URL     string                              `json:"url" yaml:"url"`
+	Related CredentialTypeRelatedResponseSchema `json:"related" yaml:"related"`
+	CredentialTypeRequestSchema
+	Created   string `json:"created" yaml:"created"`
+	Modified  string `json:"modified" yaml:"modified"`
+	Namespace string `json:"namespace" yaml:"namespace"`
+	Managed   bool   `json:"managed" yaml:"managed"`
+}
+
+// CredentialTypeResponseSchema is the schema for a credential type response
+type CredentialTypeResponseSchema struct {
+	Count    int32                                `json:"count" yaml:"count"`
+	Next     string                               `json:"next" yaml:"next"`
+	Previous string                               `json:"previous" yaml:"previous"`
+	Results  []CredentialTypeResponseSingleSchema `json:"results" yaml:"results"`
+}
+
+// MachineCredentialInputs is the schema for the inputs of a machine credential
+type MachineCredentialInputs struct {
+	Username       string       `json:"username,omitempty" yaml:"username,omitempty"`
+	Password       SecretString `json:"password,omitempty" yaml:"password,omitempty"`
+	SSHKeyData     SecretString `json:"ssh_key_data,omitempty" yaml:"ssh_key_data,omitempty"`
+	SSHKeyUnlock   SecretString `json:"ssh_key_unlock,omitempty" yaml:"ssh_key_unlock,omitempty"`
+	BecomeMethod   string       `json:"become_method,omitempty" yaml:"become_method,omitempty"`
+	BecomeUsername string       `json:"become_username,omitempty" yaml:"become_username,omitempty"`
+	BecomePassword SecretString `json:"become_password,omitempty" yaml:"become_password,omitempty"`
+}
+
+// NetworkCredentialInputs is the schema for the inputs of a network credential
+type NetworkCredentialInputs struct {
+	Username          string       `json:"username,omitempty" yaml:"username,omitempty"`
+	Password          SecretString `json:"password,omitempty" yaml:"password,omitempty"`
+	SSHKeyData        SecretString `json:"ssh_key_data,omitempty" yaml:"ssh_key_data,omitempty"`
+	SSHKeyUnlock      SecretString `json:"ssh_key_unlock,omitempty" yaml:"ssh_key_unlock,omitempty"`
+	Authorize         bool         `json:"authorize,omitempty" yaml:"authorize,omitempty"`
+	AuthorizePassword SecretString `json:"authorize_password,omitempty" yaml:"authorize_password,omitempty"`
+}
+
+// SourceControlCredentialInputs is the schema for the inputs of a source control credential
+type SourceControlCredentialInputs struct {
+	Username     string       `json:"username,omitempty" yaml:"username,omitempty"`
+	Password     SecretString `json:"password,omitempty" yaml:"password,omitempty"`
+	SSHKeyData   SecretString `json:"ssh_key_data,omitempty" yaml:"ssh_key_data,omitempty"`
+	SSHKeyUnlock SecretString `json:"ssh_key_unlock,omitempty" yaml:"ssh_key_unlock,omitempty"`
+}
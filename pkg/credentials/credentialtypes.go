@@ -0,0 +1,188 @@
+package credentials
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/connection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/dataconversion"
+)
+
+// CredentialType represents an AAP credential type
+type CredentialType struct {
+	URI            string
+	connection     connection.BasicConnection
+	DataConversion dataconversion.DataConverterInterface
+}
+
+// NewCredentialType creates a new credential type instance
+//
+//	:param basicConnection: The basic connection to use
+func NewCredentialType(basicConnection connection.BasicConnection) *CredentialType {
+	return &CredentialType{
+		URI:            "credential_types/",
+		connection:     basicConnection,
+		DataConversion: dataconversion.NewDataConverter(),
+	}
+}
+
+// GetAllCredentialTypes gets all credential types
+func (credentialType *CredentialType) GetAllCredentialTypes() (schemaResponse CredentialTypeResponseSchema, err error) {
+	schemaResponse = CredentialTypeResponseSchema{}
+
+	response, err := credentialType.connection.Get(credentialType.URI, nil)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = credentialType.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// GetCredentialType gets a credential type by name
+//
+//	:param name: The name of the credential type to get
+func (credentialType *CredentialType) GetCredentialType(name string) (schemaResponse CredentialTypeResponseSchema, err error) {
+	schemaResponse = CredentialTypeResponseSchema{}
+
+	params := map[string]string{
+		"name": name,
+	}
+
+	response, err := credentialType.connection.Get(credentialType.URI, params)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = credentialType.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// GetCredentialTypeID gets a credential type ID by name
+//
+//	:param name: The name of the credential type to get
+func (credentialType *CredentialType) GetCredentialTypeID(name string) (id int32, err error) {
+	schemaResponse, err := credentialType.GetCredentialType(name)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if len(schemaResponse.Results) > 1 {
+		return 0, fmt.Errorf("more than one credential type found with name %s", name)
+	}
+
+	if len(schemaResponse.Results) == 0 {
+		return 0, fmt.Errorf("no credential type found with name %s", name)
+	}
+
+	return schemaResponse.Results[0].ID, nil
+}
+
+// CreateCredentialType creates a new custom credential type
+//
+//	:param credentialTypeRequest: The credential type request schema to use
+func (credentialType *CredentialType) CreateCredentialType(credentialTypeRequest CredentialTypeRequestSchema) (schemaResponse CredentialTypeResponseSingleSchema, err error) {
+	schemaResponse = CredentialTypeResponseSingleSchema{}
+
+	data, err := json.Marshal(credentialTypeRequest)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	response, err := credentialType.connection.Post(credentialType.URI, data)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = credentialType.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// UpdateCredentialType updates a custom credential type by ID
+//
+//	:param id: The ID of the credential type to update
+//	:param credentialTypeRequest: The credential type request schema to use
+func (credentialType *CredentialType) UpdateCredentialType(id int32, credentialTypeRequest CredentialTypeRequestSchema) (schemaResponse CredentialTypeResponseSingleSchema, err error) {
+	schemaResponse = CredentialTypeResponseSingleSchema{}
+
+	uri := fmt.Sprintf("%s%d/", credentialType.URI, id)
+
+	data, err := json.Marshal(credentialTypeRequest)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	response, err := credentialType.connection.Patch(uri, data)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = credentialType.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// DeleteCredentialType deletes a custom credential type by ID
+//
+//	:param id: The ID of the credential type to delete
+func (credentialType *CredentialType) DeleteCredentialType(id int32) (statusCode int, err error) {
+	uri := fmt.Sprintf("%s%d/", credentialType.URI, id)
+
+	response, err := credentialType.connection.Delete(uri, nil)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response.StatusCode, nil
+}
+
+// TestCredentialType tests an external lookup with a credential type without creating a credential
+//
+//	:param id: The ID of the credential type to test
+//	:param inputs: The inputs to test with
+//	:param metadata: The lookup metadata to test with, for example the secret path
+func (credentialType *CredentialType) TestCredentialType(id int32, inputs CredentialInputs, metadata map[string]string) (statusCode int, err error) {
+	uri := fmt.Sprintf("%s%d/test/", credentialType.URI, id)
+
+	data, err := json.Marshal(CredentialTestRequestSchema{Inputs: inputs, Metadata: metadata})
+
+	if err != nil {
+		return 0, err
+	}
+
+	response, err := credentialType.connection.Post(uri, data)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer response.Body.Close()
+
+	return response.StatusCode, nil
+}
@@ -0,0 +1,92 @@
+package credentials
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RedactedValue is the value printed in place of a secret
+const RedactedValue = "********"
+
+// secretKeyFragments are the fragments of an input key that mark the input as secret
+var secretKeyFragments = []string{
+	"password",
+	"secret",
+	"token",
+	"ssh_key_data",
+	"ssh_key_unlock",
+	"api_key",
+	"private_key",
+}
+
+// SecretString is a string that is sent to AAP as is, but never printed
+type SecretString string
+
+// Format implements fmt.Formatter so a secret is redacted by every fmt verb
+//
+//	:param state: The fmt state to write to
+//	:param verb: The fmt verb being formatted
+func (secret SecretString) Format(state fmt.State, verb rune) {
+	if secret == "" {
+		_, _ = fmt.Fprintf(state, fmt.FormatString(state, verb), "")
+		return
+	}
+
+	_, _ = fmt.Fprintf(state, fmt.FormatString(state, verb), RedactedValue)
+}
+
+// IsSecretInputKey checks if a credential input key holds a secret
+//
+//	:param key: The input key to check
+func IsSecretInputKey(key string) bool {
+	lowerKey := strings.ToLower(key)
+
+	for _, fragment := range secretKeyFragments {
+		if strings.Contains(lowerKey, fragment) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CredentialInputs are the inputs of a credential, secret inputs are redacted when printed
+type CredentialInputs map[string]any
+
+// Redacted returns a copy of the inputs with the secret values replaced
+func (inputs CredentialInputs) Redacted() map[string]any {
+	redacted := make(map[string]any, len(inputs))
+
+	for key, value := range inputs {
+		switch typedValue := value.(type) {
+		case SecretString:
+			if typedValue != "" {
+				redacted[key] = RedactedValue
+			} else {
+				redacted[key] = ""
+			}
+		case string:
+			if IsSecretInputKey(key) && typedValue != "" && typedValue != "$encrypted$" {
+				redacted[key] = RedactedValue
+			} else {
+				redacted[key] = typedValue
+			}
+		default:
+			if IsSecretInputKey(key) {
+				redacted[key] = RedactedValue
+			} else {
+				redacted[key] = typedValue
+			}
+		}
+	}
+
+	return redacted
+}
+
+// Format implements fmt.Formatter so secret inputs are redacted by every fmt verb
+//
+//	:param state: The fmt state to write to
+//	:param verb: The fmt verb being formatted
+func (inputs CredentialInputs) Format(state fmt.State, verb rune) {
+	_, _ = fmt.Fprintf(state, fmt.FormatString(state, verb), inputs.Redacted())
+}
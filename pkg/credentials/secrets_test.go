@@ -0,0 +1,105 @@
+package credentials
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestSecretString_Format(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		secret SecretString
+		want   string
+	}{
+		{
+			name:   "Test SecretString %s",
+			format: "%s",
+			secret: "hunter2",
+			want:   RedactedValue,
+		},
+		{
+			name:   "Test SecretString %v",
+			format: "%v",
+			secret: "hunter2",
+			want:   RedactedValue,
+		},
+		{
+			name:   "Test SecretString %q",
+			format: "%q",
+			secret: "hunter2",
+			want:   fmt.Sprintf("%q", RedactedValue),
+		},
+		{
+			name:   "Test SecretString empty",
+			format: "%s",
+			secret: "",
+			want:   "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.secret); got != tt.want {
+				t.Errorf("SecretString.Format() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSecretString_MarshalJSON(t *testing.T) {
+	inputs := MachineCredentialInputs{Username: "admin", Password: "hunter2"}
+
+	got, err := json.Marshal(inputs)
+
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	if !strings.Contains(string(got), "hunter2") {
+		t.Errorf("json.Marshal() = %s, want the password to be sent to AAP", got)
+	}
+}
+
+func TestCredentialInputs_Format(t *testing.T) {
+	request := CredentialRequestSchema{
+		Name: "switches",
+		Inputs: CredentialInputs{
+			"username":           "admin",
+			"password":           "hunter2",
+			"authorize_password": "enable-me",
+			"custom_field":       SecretString("custom-secret"),
+		},
+	}
+
+	for _, format := range []string{"%v", "%+v", "%#v", "%s"} {
+		got := fmt.Sprintf(format, request)
+
+		for _, secret := range []string{"hunter2", "enable-me", "custom-secret"} {
+			if strings.Contains(got, secret) {
+				t.Errorf("fmt.Sprintf(%q) = %s, leaked %s", format, got, secret)
+			}
+		}
+
+		if !strings.Contains(got, "admin") {
+			t.Errorf("fmt.Sprintf(%q) = %s, want the username to be printed", format, got)
+		}
+	}
+}
+
+func TestToCredentialInputs(t *testing.T) {
+	got, err := ToCredentialInputs(NetworkCredentialInputs{Username: "admin", Password: "hunter2", Authorize: true})
+
+	if err != nil {
+		t.Fatalf("ToCredentialInputs() error = %v", err)
+	}
+
+	if got["username"] != "admin" || got["password"] != "hunter2" || got["authorize"] != true {
+		t.Errorf("ToCredentialInputs() = %#v", map[string]any(got))
+	}
+
+	if _, ok := got["ssh_key_data"]; ok {
+		t.Errorf("ToCredentialInputs() included an empty ssh_key_data")
+	}
+}
@@ -0,0 +1,404 @@
+/*
+Package inventorysources provides a way to manipulate inventory sources and inventory updates for Ansible AAP
+*/
+package inventorysources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/connection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/dataconversion"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/polling"
+	"io"
+)
+
+// Inventory source types supported by AAP
+const (
+	SourceSCM         = "scm"
+	SourceEC2         = "ec2"
+	SourceGCE         = "gce"
+	SourceAzureRM     = "azure_rm"
+	SourceVMware      = "vmware"
+	SourceSatellite6  = "satellite6"
+	SourceOpenStack   = "openstack"
+	SourceRHV         = "rhv"
+	SourceController  = "controller"
+	SourceInsights    = "insights"
+	SourceTerraform   = "terraform"
+	SourceConstructed = "constructed"
+)
+
+// InventorySource represents an AAP inventory source
+type InventorySource struct {
+	URI                string
+	InventoryUpdateURI string
+	InventoryURI       string
+	connection         connection.BasicConnection
+	DataConversion     dataconversion.DataConverterInterface
+}
+
+// NewInventorySource creates a new inventory source instance
+//
+//	:param basicConnection: The basic connection to use
+func NewInventorySource(basicConnection connection.BasicConnection) *InventorySource {
+	return &InventorySource{
+		URI:                "inventory_sources/",
+		InventoryUpdateURI: "inventory_updates/",
+		InventoryURI:       "inventories/",
+		connection:         basicConnection,
+		DataConversion:     dataconversion.NewDataConverter(),
+	}
+}
+
+// GetAllInventorySources gets all inventory sources
+func (inventorySource *InventorySource) GetAllInventorySources() (schemaResponse InventorySourceResponseSchema, err error) {
+	schemaResponse = InventorySourceResponseSchema{}
+
+	response, err := inventorySource.connection.Get(inventorySource.URI, nil)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = inventorySource.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// GetInventorySourcesForInventory gets all inventory sources of an inventory
+//
+//	:param inventoryID: The ID of the inventory to get the inventory sources for
+func (inventorySource *InventorySource) GetInventorySourcesForInventory(inventoryID int32) (schemaResponse InventorySourceResponseSchema, err error) {
+	schemaResponse = InventorySourceResponseSchema{}
+
+	uri := fmt.Sprintf("%s%d/inventory_sources/", inventorySource.InventoryURI, inventoryID)
+
+	response, err := inventorySource.connection.Get(uri, nil)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = inventorySource.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// GetInventorySource gets an inventory source by name
+//
+//	:param name: The name of the inventory source to get
+func (inventorySource *InventorySource) GetInventorySource(name string) (schemaResponse InventorySourceResponseSchema, err error) {
+	schemaResponse = InventorySourceResponseSchema{}
+
+	params := map[string]string{
+		"name": name,
+	}
+
+	response, err := inventorySource.connection.Get(inventorySource.URI, params)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = inventorySource.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// GetInventorySourceID gets an inventory source ID by name
+//
+//	:param name: The name of the inventory source to get
+func (inventorySource *InventorySource) GetInventorySourceID(name string) (id int32, err error) {
+	schemaResponse, err := inventorySource.GetInventorySource(name)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if len(schemaResponse.Results) > 1 {
+		return 0, fmt.Errorf("more than one inventory source found with name %s", name)
+	}
+
+	if len(schemaResponse.Results) == 0 {
+		return 0, fmt.Errorf("no inventory source found with name %s", name)
+	}
+
+	return schemaResponse.Results[0].ID, nil
+}
+
+// CreateInventorySource creates a new inventory source
+//
+//	:param inventorySourceRequest: The inventory source request schema to use
+func (inventorySource *InventorySource) CreateInventorySource(inventorySourceRequest InventorySourceRequestSchema) (schemaResponse InventorySourceResponseSingleSchema, err error) {
+	schemaResponse = InventorySourceResponseSingleSchema{}
+
+	data, err := json.Marshal(inventorySourceRequest)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	response, err := inventorySource.connection.Post(inventorySource.URI, data)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = inventorySource.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// UpdateInventorySource updates an inventory source by ID
+//
+//	:param id: The ID of the inventory source to update
+//	:param inventorySourceRequest: The inventory source request schema to use
+func (inventorySource *InventorySource) UpdateInventorySource(id int32, inventorySourceRequest InventorySourceRequestSchema) (schemaResponse InventorySourceResponseSingleSchema, err error) {
+	schemaResponse = InventorySourceResponseSingleSchema{}
+
+	uri := fmt.Sprintf("%s%d/", inventorySource.URI, id)
+
+	data, err := json.Marshal(inventorySourceRequest)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	response, err := inventorySource.connection.Patch(uri, data)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = inventorySource.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// SetUpdateOptions sets the update on launch and overwrite options of an inventory source by ID
+//
+//	:param id: The ID of the inventory source to update
+//	:param updateOnLaunch: Whether to update the inventory source when a job using the inventory is launched
+//	:param updateCacheTimeout: The number of seconds an update is considered current for update on launch
+//	:param overwrite: Whether to remove hosts and groups no longer in the source
+//	:param overwriteVars: Whether to replace variables with the ones from the source
+func (inventorySource *InventorySource) SetUpdateOptions(id int32, updateOnLaunch bool, updateCacheTimeout int32, overwrite bool, overwriteVars bool) (schemaResponse InventorySourceResponseSingleSchema, err error) {
+	schemaResponse = InventorySourceResponseSingleSchema{}
+
+	uri := fmt.Sprintf("%s%d/", inventorySource.URI, id)
+
+	data, err := json.Marshal(map[string]any{
+		"update_on_launch":     updateOnLaunch,
+		"update_cache_timeout": updateCacheTimeout,
+		"overwrite":            overwrite,
+		"overwrite_vars":       overwriteVars,
+	})
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	response, err := inventorySource.connection.Patch(uri, data)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = inventorySource.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// DeleteInventorySource deletes an inventory source by ID
+//
+//	:param id: The ID of the inventory source to delete
+func (inventorySource *InventorySource) DeleteInventorySource(id int32) (statusCode int, err error) {
+	uri := fmt.Sprintf("%s%d/", inventorySource.URI, id)
+
+	response, err := inventorySource.connection.Delete(uri, nil)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response.StatusCode, nil
+}
+
+// SyncInventorySource starts an inventory update for an inventory source by ID
+//
+//	:param id: The ID of the inventory source to sync
+func (inventorySource *InventorySource) SyncInventorySource(id int32) (schemaResponse InventorySourceUpdateResponseSchema, err error) {
+	schemaResponse = InventorySourceUpdateResponseSchema{}
+
+	uri := fmt.Sprintf("%s%d/update/", inventorySource.URI, id)
+
+	response, err := inventorySource.connection.Post(uri, []byte("{}"))
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = inventorySource.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	if schemaResponse.InventoryUpdate == 0 {
+		schemaResponse.InventoryUpdate = schemaResponse.ID
+	}
+
+	return schemaResponse, nil
+}
+
+// SyncAllInventorySources starts an inventory update for every inventory source of an inventory
+//
+//	:param inventoryID: The ID of the inventory to sync
+func (inventorySource *InventorySource) SyncAllInventorySources(inventoryID int32) (schemaResponse []InventorySourceUpdateResponseSchema, err error) {
+	schemaResponse = []InventorySourceUpdateResponseSchema{}
+
+	uri := fmt.Sprintf("%s%d/update_inventory_sources/", inventorySource.InventoryURI, inventoryID)
+
+	response, err := inventorySource.connection.Post(uri, []byte("{}"))
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = inventorySource.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// GetInventoryUpdate gets an inventory update by ID
+//
+//	:param id: The ID of the inventory update to get
+func (inventorySource *InventorySource) GetInventoryUpdate(id int32) (schemaResponse InventoryUpdateResponseSingleSchema, err error) {
+	schemaResponse = InventoryUpdateResponseSingleSchema{}
+
+	uri := fmt.Sprintf("%s%d/", inventorySource.InventoryUpdateURI, id)
+
+	response, err := inventorySource.connection.Get(uri, nil)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = inventorySource.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// GetInventoryUpdateStatus gets the status of an inventory update by ID
+//
+//	:param id: The ID of the inventory update to get the status for
+func (inventorySource *InventorySource) GetInventoryUpdateStatus(id int32) (status string, err error) {
+	response, err := inventorySource.GetInventoryUpdate(id)
+
+	if err != nil {
+		return "", err
+	}
+
+	if response.Status == "" {
+		return "", fmt.Errorf("status not found for inventory update %d", id)
+	}
+
+	return response.Status, nil
+}
+
+// GetInventoryUpdateStdOut gets the standard output of an inventory update by ID
+//
+//	:param id: The ID of the inventory update to get the standard output for
+//	:param outputFormat: The format to get the output in ("txt", "ansi", "json", "html")
+func (inventorySource *InventorySource) GetInventoryUpdateStdOut(id int32, outputFormat string) (response string, err error) {
+	params := map[string]string{
+		"format": outputFormat,
+	}
+
+	uri := fmt.Sprintf("%s%d/stdout/", inventorySource.InventoryUpdateURI, id)
+
+	resp, err := inventorySource.connection.Get(uri, params)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(body), nil
+}
+
+// WaitForInventoryUpdate polls an inventory update until it reaches a finished status
+//
+//	:param ctx: The context used to stop waiting
+//	:param id: The ID of the inventory update to wait for
+//	:param options: The poll options, the interval must be positive
+func (inventorySource *InventorySource) WaitForInventoryUpdate(ctx context.Context, id int32, options polling.Options) (status string, err error) {
+	watch := polling.Watch{Label: "Inventory Update", ID: id}
+
+	return polling.WaitForStatus(ctx, options, watch, func() (string, error) {
+		return inventorySource.GetInventoryUpdateStatus(id)
+	})
+}
+
+// SyncAndWait syncs an inventory source and waits for the resulting inventory update to finish
+//
+//	:param ctx: The context used to stop waiting
+//	:param id: The ID of the inventory source to sync
+//	:param options: The poll options, the interval must be positive
+func (inventorySource *InventorySource) SyncAndWait(ctx context.Context, id int32, options polling.Options) (schemaResponse InventoryUpdateResponseSingleSchema, err error) {
+	schemaResponse = InventoryUpdateResponseSingleSchema{}
+
+	syncResponse, err := inventorySource.SyncInventorySource(id)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	_, err = inventorySource.WaitForInventoryUpdate(ctx, syncResponse.InventoryUpdate, options)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return inventorySource.GetInventoryUpdate(syncResponse.InventoryUpdate)
+}
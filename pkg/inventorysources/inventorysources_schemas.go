@@ -0,0 +1,119 @@
+package inventorysources
+
+// InventorySourceRequestSchema is the schema for an inventory source request
+type InventorySourceRequestSchema struct {
+	Name                 string `json:"name" yaml:"name"`
+	Description          string `json:"description" yaml:"description"`
+	Inventory            int32  `json:"inventory" yaml:"inventory"`
+	Source               string `json:"source" yaml:"source"`
+	SourcePath           string `json:"source_path" yaml:"source_path"`
+	SourceVars           string `json:"source_vars" yaml:"source_vars"`
+	SourceProject        int32  `json:"source_project,omitempty" yaml:"source_project,omitempty"`
+	ScmBranch            string `json:"scm_branch" yaml:"scm_branch"`
+	Credential           int32  `json:"credential,omitempty" yaml:"credential,omitempty"`
+	ExecutionEnvironment int32  `json:"execution_environment,omitempty" yaml:"execution_environment,omitempty"`
+	EnabledVar           string `json:"enabled_var" yaml:"enabled_var"`
+	EnabledValue         string `json:"enabled_value" yaml:"enabled_value"`
+	HostFilter           string `json:"host_filter" yaml:"host_filter"`
+	Overwrite            bool   `json:"overwrite" yaml:"overwrite"`
+	OverwriteVars        bool   `json:"overwrite_vars" yaml:"overwrite_vars"`
+	Timeout              int32  `json:"timeout" yaml:"timeout"`
+	Verbosity            int32  `json:"verbosity" yaml:"verbosity"`
+	Limit                string `json:"limit" yaml:"limit"`
+	UpdateOnLaunch       bool   `json:"update_on_launch" yaml:"update_on_launch"`
+	UpdateCacheTimeout   int32  `json:"update_cache_timeout" yaml:"update_cache_timeout"`
+}
+
+// InventorySourceRelatedResponseSchema is the schema for the related section of a response
+type InventorySourceRelatedResponseSchema struct {
+	CreatedBy                    string `json:"created_by" yaml:"created_by"`
+	ModifiedBy                   string `json:"modified_by" yaml:"modified_by"`
+	Update                       string `json:"update" yaml:"update"`
+	InventoryUpdates             string `json:"inventory_updates" yaml:"inventory_updates"`
+	Schedules                    string `json:"schedules" yaml:"schedules"`
+	ActivityStream               string `json:"activity_stream" yaml:"activity_stream"`
+	Hosts                        string `json:"hosts" yaml:"hosts"`
+	Groups                       string `json:"groups" yaml:"groups"`
+	NotificationTemplatesStarted string `json:"notification_templates_started" yaml:"notification_templates_started"`
+	NotificationTemplatesSuccess string `json:"notification_templates_success" yaml:"notification_templates_success"`
+	NotificationTemplatesError   string `json:"notification_templates_error" yaml:"notification_templates_error"`
+	Inventory                    string `json:"inventory" yaml:"inventory"`
+	SourceProject                string `json:"source_project" yaml:"source_project"`
+	Credentials                  string `json:"credentials" yaml:"credentials"`
+	LastJob                      string `json:"last_job" yaml:"last_job"`
+	LastUpdate                   string `json:"last_update" yaml:"last_update"`
+}
+
+// InventorySourceResponseSingleSchema is the schema for a single inventory source response item
+type InventorySourceResponseSingleSchema struct {
+	ID      int32                                `json:"id" yaml:"id"`
+	Type    string                               `json:"type" yaml:"type"`
+	URL     string                               `json:"url" yaml:"url"`
+	Related InventorySourceRelatedResponseSchema `json:"related" yaml:"related"`
+	InventorySourceRequestSchema
+	Created          string `json:"created" yaml:"created"`
+	Modified         string `json:"modified" yaml:"modified"`
+	LastJobRun       string `json:"last_job_run" yaml:"last_job_run"`
+	LastJobFailed    bool   `json:"last_job_failed" yaml:"last_job_failed"`
+	NextJobRun       string `json:"next_job_run" yaml:"next_job_run"`
+	Status           string `json:"status" yaml:"status"`
+	LastUpdateFailed bool   `json:"last_update_failed" yaml:"last_update_failed"`
+	LastUpdated      string `json:"last_updated" yaml:"last_updated"`
+}
+
+// InventorySourceResponseSchema is the schema for an inventory source response
+type InventorySourceResponseSchema struct {
+	Count    int32                                 `json:"count" yaml:"count"`
+	Next     string                                `json:"next" yaml:"next"`
+	Previous string                                `json:"previous" yaml:"previous"`
+	Results  []InventorySourceResponseSingleSchema `json:"results" yaml:"results"`
+}
+
+// InventorySourceUpdateResponseSchema is the schema for the response of an inventory source update
+type InventorySourceUpdateResponseSchema struct {
+	InventoryUpdate int32  `json:"inventory_update" yaml:"inventory_update"`
+	InventorySource int32  `json:"inventory_source" yaml:"inventory_source"`
+	Status          string `json:"status" yaml:"status"`
+	ID              int32  `json:"id" yaml:"id"`
+	Type            string `json:"type" yaml:"type"`
+	URL             string `json:"url" yaml:"url"`
+}
+
+// InventoryUpdateRelatedResponseSchema is the schema for the related section of an inventory update response
+type InventoryUpdateRelatedResponseSchema struct {
+	CreatedBy            string `json:"created_by" yaml:"created_by"`
+	UnifiedJobTemplate   string `json:"unified_job_template" yaml:"unified_job_template"`
+	StdOut               string `json:"stdout" yaml:"stdout"`
+	InventorySource      string `json:"inventory_source" yaml:"inventory_source"`
+	Cancel               string `json:"cancel" yaml:"cancel"`
+	Notifications        string `json:"notifications" yaml:"notifications"`
+	Events               string `json:"events" yaml:"events"`
+	Inventory            string `json:"inventory" yaml:"inventory"`
+	ExecutionEnvironment string `json:"execution_environment" yaml:"execution_environment"`
+}
+
+// InventoryUpdateResponseSingleSchema is the schema for a single inventory update response item
+type InventoryUpdateResponseSingleSchema struct {
+	ID                 int32                                `json:"id" yaml:"id"`
+	Type               string                               `json:"type" yaml:"type"`
+	URL                string                               `json:"url" yaml:"url"`
+	Related            InventoryUpdateRelatedResponseSchema `json:"related" yaml:"related"`
+	Created            string                               `json:"created" yaml:"created"`
+	Modified           string                               `json:"modified" yaml:"modified"`
+	Name               string                               `json:"name" yaml:"name"`
+	Description        string                               `json:"description" yaml:"description"`
+	UnifiedJobTemplate int32                                `json:"unified_job_template" yaml:"unified_job_template"`
+	LaunchType         string                               `json:"launch_type" yaml:"launch_type"`
+	Status             string                               `json:"status" yaml:"status"`
+	Failed             bool                                 `json:"failed" yaml:"failed"`
+	Started            string                               `json:"started" yaml:"started"`
+	Finished           string                               `json:"finished" yaml:"finished"`
+	Elapsed            float32                              `json:"elapsed" yaml:"elapsed"`
+	JobExplanation     string                               `json:"job_explanation" yaml:"job_explanation"`
+	ExecutionNode      string                               `json:"execution_node" yaml:"execution_node"`
+	Inventory          int32                                `json:"inventory" yaml:"inventory"`
+	InventorySource    int32                                `json:"inventory_source" yaml:"inventory_source"`
+	Source             string                               `json:"source" yaml:"source"`
+	LicenseError       bool                                 `json:"license_error" yaml:"license_error"`
+	OrgHostLimitError  bool                                 `json:"org_host_limit_error" yaml:"org_host_limit_error"`
+}
@@ -0,0 +1,118 @@
+package inventorysources
+
+import (
+	"context"
+	"errors"
+	"github.com/btr1975/go-ansible-aap-api-client/internal/fakeconnection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/polling"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// newFakeUpdateConnection answers the sync of inventory source 3 with an update response and inventory update 40
+// with a sequence of statuses, one per poll
+func newFakeUpdateConnection(syncResponse map[string]any, statuses ...string) *fakeconnection.Connection {
+	polls := 0
+
+	connection := fakeconnection.New().HandleJSON(http.MethodPost, "inventory_sources/3/update/", syncResponse)
+
+	connection.Handle(http.MethodGet, "inventory_updates/40/", func(fakeconnection.Request) (*http.Response, error) {
+		status := statuses[min(polls, len(statuses)-1)]
+		polls++
+
+		if status == "reset" {
+			return nil, errors.New("connection reset by peer")
+		}
+
+		return fakeconnection.JSON(InventoryUpdateResponseSingleSchema{ID: 40, Status: status})
+	})
+
+	return connection
+}
+
+func TestInventorySource_SyncInventorySource(t *testing.T) {
+	tests := []struct {
+		name         string
+		syncResponse map[string]any
+	}{
+		{
+			name:         "Test SyncInventorySource inventory update",
+			syncResponse: map[string]any{"inventory_update": 40, "id": 40, "status": "pending"},
+		},
+		{
+			name:         "Test SyncInventorySource falls back to the ID",
+			syncResponse: map[string]any{"id": 40, "status": "pending"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, err := NewInventorySource(newFakeUpdateConnection(tt.syncResponse, "pending")).SyncInventorySource(3)
+
+			if err != nil || response.InventoryUpdate != 40 {
+				t.Errorf("InventorySource.SyncInventorySource() = %+v, err = %v, want inventory update 40", response, err)
+			}
+		})
+	}
+}
+
+func TestInventorySource_WaitForInventoryUpdate(t *testing.T) {
+	tests := []struct {
+		name       string
+		statuses   []string
+		wantStatus string
+		wantErr    bool
+		wantIs     error
+	}{
+		{
+			name:       "Test WaitForInventoryUpdate successful",
+			statuses:   []string{"pending", "running", "successful"},
+			wantStatus: "successful",
+		},
+		{
+			name:       "Test WaitForInventoryUpdate failed",
+			statuses:   []string{"running", "failed"},
+			wantStatus: "failed",
+		},
+		{
+			name:       "Test WaitForInventoryUpdate missing status",
+			statuses:   []string{"running", ""},
+			wantStatus: "running",
+			wantErr:    true,
+		},
+		{
+			name:       "Test WaitForInventoryUpdate connection error",
+			statuses:   []string{"reset"},
+			wantStatus: "new",
+			wantErr:    true,
+		},
+		{
+			name:       "Test WaitForInventoryUpdate timeout",
+			statuses:   []string{"running"},
+			wantStatus: "running",
+			wantErr:    true,
+			wantIs:     polling.ErrTimeout,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inventorySource := NewInventorySource(newFakeUpdateConnection(nil, tt.statuses...))
+
+			status, err := inventorySource.WaitForInventoryUpdate(context.Background(), 40, polling.Options{Interval: time.Millisecond, MaxWait: 20 * time.Millisecond})
+
+			if status != tt.wantStatus || (err != nil) != tt.wantErr || (tt.wantIs != nil && !errors.Is(err, tt.wantIs)) {
+				t.Errorf("InventorySource.WaitForInventoryUpdate() = %s, %v, want %s and error %v", status, err, tt.wantStatus, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestInventorySource_SyncAndWait(t *testing.T) {
+	connection := newFakeUpdateConnection(map[string]any{"id": 40}, "pending", "successful")
+
+	response, err := NewInventorySource(connection).SyncAndWait(context.Background(), 3, polling.Options{Interval: time.Millisecond})
+
+	if err != nil || response.ID != 40 || response.Status != "successful" {
+		t.Errorf("InventorySource.SyncAndWait() = %+v, err = %v, want inventory update 40 successful", response, err)
+	}
+}
@@ -0,0 +1,94 @@
+package jobs
+
+import (
+	"fmt"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/common"
+)
+
+// GetJobHostSummaries gets the per host summaries of a job, following the pages of the response
+//
+//	:param id: The ID of the job
+func (job *Job) GetJobHostSummaries(id int32) (summaries []JobHostSummaryResponseSingleSchema, err error) {
+	uri := fmt.Sprintf("%s%d/job_host_summaries/", job.URI, id)
+
+	return common.GetAllPages[JobHostSummaryResponseSingleSchema](job.connection, job.DataConversion, uri, map[string]string{"order_by": "host_name"})
+}
+
+// GetFailedHosts gets the hosts that failed or were unreachable in a job with the first failing task of each
+//
+//	:param id: The ID of the job
+func (job *Job) GetFailedHosts(id int32) (failedHosts []FailedHostSchema, err error) {
+	failedHosts = []FailedHostSchema{}
+
+	summaries, err := job.GetJobHostSummaries(id)
+
+	if err != nil {
+		return failedHosts, err
+	}
+
+	for _, summary := range summaries {
+		if summary.Failures > 0 || summary.Dark > 0 {
+			failedHosts = append(failedHosts, FailedHostSchema{
+				Host:        summary.Host,
+				HostName:    summary.HostName,
+				Unreachable: summary.Dark > 0,
+				Failures:    summary.Failures,
+				Dark:        summary.Dark,
+			})
+		}
+	}
+
+	if len(failedHosts) == 0 {
+		return failedHosts, nil
+	}
+
+	events, err := job.GetJobEvents(id, JobEventFilter{Events: []string{EventRunnerOnFailed, EventRunnerOnUnreachable}})
+
+	if err != nil {
+		return failedHosts, err
+	}
+
+	return firstFailures(failedHosts, events), nil
+}
+
+// firstFailures fills in the first failing task and message of each failed host
+//
+//	:param failedHosts: The failed hosts from the host summaries
+//	:param events: The failed and unreachable events ordered by counter
+func firstFailures(failedHosts []FailedHostSchema, events []JobEventResponseSingleSchema) []FailedHostSchema {
+	index := map[string]int{}
+
+	for i, failedHost := range failedHosts {
+		index[failedHost.HostName] = i
+	}
+
+	for _, event := range events {
+		i, ok := index[event.HostName]
+
+		if !ok || failedHosts[i].Task != "" {
+			continue
+		}
+
+		data, err := event.TypedEventData()
+
+		if err != nil {
+			continue
+		}
+
+		switch eventData := data.(type) {
+		case *RunnerOnFailedEventDataSchema:
+			if eventData.IgnoreErrors {
+				continue
+			}
+
+			failedHosts[i].Message = eventData.Res.Message()
+		case *RunnerOnUnreachableEventDataSchema:
+			failedHosts[i].Message = eventData.Res.Message()
+		}
+
+		failedHosts[i].Play = event.Play
+		failedHosts[i].Task = event.Task
+	}
+
+	return failedHosts
+}
@@ -0,0 +1,42 @@
+package jobs
+
+// JobHostSummaryResponseSingleSchema is the schema for a single job host summary response
+type JobHostSummaryResponseSingleSchema struct {
+	ID        int32  `json:"id" yaml:"id"`
+	Type      string `json:"type" yaml:"type"`
+	URL       string `json:"url" yaml:"url"`
+	Created   string `json:"created" yaml:"created"`
+	Modified  string `json:"modified" yaml:"modified"`
+	Job       int32  `json:"job" yaml:"job"`
+	Host      int32  `json:"host" yaml:"host"`
+	HostName  string `json:"host_name" yaml:"host_name"`
+	Changed   int32  `json:"changed" yaml:"changed"`
+	Dark      int32  `json:"dark" yaml:"dark"`
+	Failures  int32  `json:"failures" yaml:"failures"`
+	Ok        int32  `json:"ok" yaml:"ok"`
+	Processed int32  `json:"processed" yaml:"processed"`
+	Skipped   int32  `json:"skipped" yaml:"skipped"`
+	Failed    bool   `json:"failed" yaml:"failed"`
+	Ignored   int32  `json:"ignored" yaml:"ignored"`
+	Rescued   int32  `json:"rescued" yaml:"rescued"`
+}
+
+// JobHostSummaryResponseSchema is the schema for a job host summaries response
+type JobHostSummaryResponseSchema struct {
+	Count    int32                                `json:"count" yaml:"count"`
+	Next     string                               `json:"next" yaml:"next"`
+	Previous string                               `json:"previous" yaml:"previous"`
+	Results  []JobHostSummaryResponseSingleSchema `json:"results" yaml:"results"`
+}
+
+// FailedHostSchema is the schema for a host that failed or was unreachable in a job
+type FailedHostSchema struct {
+	Host        int32  `json:"host" yaml:"host"`
+	HostName    string `json:"host_name" yaml:"host_name"`
+	Unreachable bool   `json:"unreachable" yaml:"unreachable"`
+	Failures    int32  `json:"failures" yaml:"failures"`
+	Dark        int32  `json:"dark" yaml:"dark"`
+	Play        string `json:"play" yaml:"play"`
+	Task        string `json:"task" yaml:"task"`
+	Message     string `json:"message" yaml:"message"`
+}
@@ -0,0 +1,30 @@
+package jobs
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func Test_firstFailures(t *testing.T) {
+	failedHosts := []FailedHostSchema{
+		{HostName: "sw1", Failures: 1},
+		{HostName: "sw2", Dark: 1, Unreachable: true},
+	}
+
+	events := []JobEventResponseSingleSchema{
+		{Event: EventRunnerOnFailed, HostName: "sw1", Task: "ignored", EventData: json.RawMessage(`{"ignore_errors": true, "res": {"msg": "ignored"}}`)},
+		{Event: EventRunnerOnFailed, HostName: "sw1", Play: "backup", Task: "save config", EventData: json.RawMessage(`{"res": {"msg": "timeout"}}`)},
+		{Event: EventRunnerOnUnreachable, HostName: "sw2", Play: "backup", Task: "gather facts", EventData: json.RawMessage(`{"res": {"msg": "no route to host"}}`)},
+		{Event: EventRunnerOnFailed, HostName: "sw1", Task: "later", EventData: json.RawMessage(`{"res": {"msg": "later"}}`)},
+	}
+
+	got := firstFailures(failedHosts, events)
+
+	if got[0].Task != "save config" || got[0].Message != "timeout" || got[0].Play != "backup" {
+		t.Errorf("firstFailures() sw1 = %+v", got[0])
+	}
+
+	if got[1].Task != "gather facts" || got[1].Message != "no route to host" {
+		t.Errorf("firstFailures() sw2 = %+v", got[1])
+	}
+}
@@ -0,0 +1,162 @@
+package jobs
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/common"
+	"strconv"
+	"strings"
+)
+
+// Job event types
+const (
+	EventPlaybookOnStart     = "playbook_on_start"
+	EventPlaybookOnPlayStart = "playbook_on_play_start"
+	EventPlaybookOnTaskStart = "playbook_on_task_start"
+	EventPlaybookOnStats     = "playbook_on_stats"
+	EventRunnerOnStart       = "runner_on_start"
+	EventRunnerOnOk          = "runner_on_ok"
+	EventRunnerOnFailed      = "runner_on_failed"
+	EventRunnerOnUnreachable = "runner_on_unreachable"
+	EventRunnerOnSkipped     = "runner_on_skipped"
+)
+
+// JobEventFilter is the filter used to get the events of a job, empty fields are not filtered on
+type JobEventFilter struct {
+	// Events are the event types to return, for example runner_on_failed
+	Events []string
+	// HostName is the name of the host the events ran against
+	HostName string
+	// Failed only returns events that failed or did not fail
+	Failed *bool
+	// Changed only returns events that changed or did not change
+	Changed *bool
+	// Task is the name of the task
+	Task string
+	// Play is the name of the play
+	Play string
+	// PageSize is the number of events requested at a time, defaults to 200
+	PageSize int
+}
+
+// params converts the filter to query parameters
+func (filter JobEventFilter) params() (params map[string]string) {
+	params = map[string]string{
+		"order_by": "counter",
+	}
+
+	switch len(filter.Events) {
+	case 0:
+	case 1:
+		params["event"] = filter.Events[0]
+	default:
+		params["event__in"] = strings.Join(filter.Events, ",")
+	}
+
+	if filter.HostName != "" {
+		params["host_name"] = filter.HostName
+	}
+
+	if filter.Failed != nil {
+		params["failed"] = strconv.FormatBool(*filter.Failed)
+	}
+
+	if filter.Changed != nil {
+		params["changed"] = strconv.FormatBool(*filter.Changed)
+	}
+
+	if filter.Task != "" {
+		params["task"] = filter.Task
+	}
+
+	if filter.Play != "" {
+		params["play"] = filter.Play
+	}
+
+	if filter.PageSize > 0 {
+		params["page_size"] = strconv.Itoa(filter.PageSize)
+	} else {
+		params["page_size"] = "200"
+	}
+
+	return params
+}
+
+// GetJobEvents gets every event of a job matching a filter, following the pages of the response
+//
+//	:param id: The ID of the job
+//	:param filter: The filter to apply
+func (job *Job) GetJobEvents(id int32, filter JobEventFilter) (events []JobEventResponseSingleSchema, err error) {
+	uri := fmt.Sprintf("%s%d/job_events/", job.URI, id)
+
+	return common.GetAllPages[JobEventResponseSingleSchema](job.connection, job.DataConversion, uri, filter.params())
+}
+
+// DecodeEventData decodes the event data of an event into a struct
+//
+//	:param data: A pointer to the struct to decode into
+func (event JobEventResponseSingleSchema) DecodeEventData(data any) (err error) {
+	if len(event.EventData) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(event.EventData, data)
+}
+
+// TypedEventData decodes the event data of an event into the struct matching its event type
+//
+// The returned value is a pointer to one of the event data schemas, for example *RunnerOnFailedEventDataSchema,
+// event types without a dedicated schema are decoded into *EventDataSchema.
+func (event JobEventResponseSingleSchema) TypedEventData() (data any, err error) {
+	switch event.Event {
+	case EventRunnerOnOk:
+		data = &RunnerOnOkEventDataSchema{}
+	case EventRunnerOnFailed:
+		data = &RunnerOnFailedEventDataSchema{}
+	case EventRunnerOnUnreachable:
+		data = &RunnerOnUnreachableEventDataSchema{}
+	case EventRunnerOnSkipped:
+		data = &RunnerOnSkippedEventDataSchema{}
+	case EventPlaybookOnPlayStart:
+		data = &PlaybookOnPlayStartEventDataSchema{}
+	case EventPlaybookOnTaskStart:
+		data = &PlaybookOnTaskStartEventDataSchema{}
+	case EventPlaybookOnStats:
+		data = &PlaybookOnStatsEventDataSchema{}
+	default:
+		data = &EventDataSchema{}
+	}
+
+	err = event.DecodeEventData(data)
+
+	if err != nil {
+		return nil, fmt.Errorf("error decoding event data of %s event %d: %w", event.Event, event.ID, err)
+	}
+
+	return data, nil
+}
+
+// Message gets the most useful message of a runner result, the msg, the standard error or the exception
+func (result RunnerResultSchema) Message() (message string) {
+	switch msg := result.Msg.(type) {
+	case string:
+		message = msg
+	case nil:
+	default:
+		data, err := json.Marshal(msg)
+
+		if err == nil {
+			message = string(data)
+		}
+	}
+
+	if message == "" {
+		message = strings.TrimSpace(result.Stderr)
+	}
+
+	if message == "" {
+		message = strings.TrimSpace(result.Exception)
+	}
+
+	return message
+}
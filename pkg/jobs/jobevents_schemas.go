@@ -0,0 +1,90 @@
+package jobs
+
+// EventDataSchema is the schema for the fields common to the event data of the playbook and runner events
+type EventDataSchema struct {
+	Playbook     string  `json:"playbook" yaml:"playbook"`
+	PlaybookUUID string  `json:"playbook_uuid" yaml:"playbook_uuid"`
+	Play         string  `json:"play" yaml:"play"`
+	PlayUUID     string  `json:"play_uuid" yaml:"play_uuid"`
+	PlayPattern  string  `json:"play_pattern" yaml:"play_pattern"`
+	Task         string  `json:"task" yaml:"task"`
+	TaskUUID     string  `json:"task_uuid" yaml:"task_uuid"`
+	TaskAction   string  `json:"task_action" yaml:"task_action"`
+	TaskPath     string  `json:"task_path" yaml:"task_path"`
+	Role         string  `json:"role" yaml:"role"`
+	Host         string  `json:"host" yaml:"host"`
+	RemoteAddr   string  `json:"remote_addr" yaml:"remote_addr"`
+	Start        string  `json:"start" yaml:"start"`
+	End          string  `json:"end" yaml:"end"`
+	Duration     float64 `json:"duration" yaml:"duration"`
+}
+
+// RunnerResultSchema is the schema for the module result of a runner event
+type RunnerResultSchema struct {
+	Changed     bool     `json:"changed" yaml:"changed"`
+	Failed      bool     `json:"failed" yaml:"failed"`
+	Unreachable bool     `json:"unreachable" yaml:"unreachable"`
+	Skipped     bool     `json:"skipped" yaml:"skipped"`
+	Msg         any      `json:"msg" yaml:"msg"`
+	Rc          *int32   `json:"rc" yaml:"rc"`
+	Stdout      any      `json:"stdout" yaml:"stdout"`
+	StdoutLines any      `json:"stdout_lines" yaml:"stdout_lines"`
+	Stderr      string   `json:"stderr" yaml:"stderr"`
+	StderrLines []string `json:"stderr_lines" yaml:"stderr_lines"`
+	Exception   string   `json:"exception" yaml:"exception"`
+	SkipReason  string   `json:"skip_reason" yaml:"skip_reason"`
+}
+
+// RunnerOnOkEventDataSchema is the schema for the event data of a runner_on_ok event
+type RunnerOnOkEventDataSchema struct {
+	EventDataSchema
+	Res RunnerResultSchema `json:"res" yaml:"res"`
+}
+
+// RunnerOnFailedEventDataSchema is the schema for the event data of a runner_on_failed event
+type RunnerOnFailedEventDataSchema struct {
+	EventDataSchema
+	Res          RunnerResultSchema `json:"res" yaml:"res"`
+	IgnoreErrors bool               `json:"ignore_errors" yaml:"ignore_errors"`
+}
+
+// RunnerOnUnreachableEventDataSchema is the schema for the event data of a runner_on_unreachable event
+type RunnerOnUnreachableEventDataSchema struct {
+	EventDataSchema
+	Res RunnerResultSchema `json:"res" yaml:"res"`
+}
+
+// RunnerOnSkippedEventDataSchema is the schema for the event data of a runner_on_skipped event
+type RunnerOnSkippedEventDataSchema struct {
+	EventDataSchema
+	Res RunnerResultSchema `json:"res" yaml:"res"`
+}
+
+// PlaybookOnPlayStartEventDataSchema is the schema for the event data of a playbook_on_play_start event
+type PlaybookOnPlayStartEventDataSchema struct {
+	EventDataSchema
+	Name    string `json:"name" yaml:"name"`
+	Pattern string `json:"pattern" yaml:"pattern"`
+}
+
+// PlaybookOnTaskStartEventDataSchema is the schema for the event data of a playbook_on_task_start event
+type PlaybookOnTaskStartEventDataSchema struct {
+	EventDataSchema
+	Name          string `json:"name" yaml:"name"`
+	IsConditional bool   `json:"is_conditional" yaml:"is_conditional"`
+}
+
+// PlaybookOnStatsEventDataSchema is the schema for the event data of a playbook_on_stats event
+type PlaybookOnStatsEventDataSchema struct {
+	Playbook     string           `json:"playbook" yaml:"playbook"`
+	PlaybookUUID string           `json:"playbook_uuid" yaml:"playbook_uuid"`
+	Changed      map[string]int32 `json:"changed" yaml:"changed"`
+	Dark         map[string]int32 `json:"dark" yaml:"dark"`
+	Failures     map[string]int32 `json:"failures" yaml:"failures"`
+	Ignored      map[string]int32 `json:"ignored" yaml:"ignored"`
+	Ok           map[string]int32 `json:"ok" yaml:"ok"`
+	Processed    map[string]int32 `json:"processed" yaml:"processed"`
+	Rescued      map[string]int32 `json:"rescued" yaml:"rescued"`
+	Skipped      map[string]int32 `json:"skipped" yaml:"skipped"`
+	ArtifactData map[string]any   `json:"artifact_data" yaml:"artifact_data"`
+}
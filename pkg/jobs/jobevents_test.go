@@ -0,0 +1,76 @@
+package jobs
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestJobEventResponseSingleSchema_TypedEventData(t *testing.T) {
+	event := JobEventResponseSingleSchema{
+		ID:    7,
+		Event: EventRunnerOnFailed,
+		EventData: json.RawMessage(`{
+			"play": "backup", "task": "save config", "task_action": "cisco.ios.ios_command", "host": "sw1",
+			"duration": 1.5, "ignore_errors": false,
+			"res": {"failed": true, "msg": "timeout value 30 seconds reached", "stdout": ["line"], "changed": false}
+		}`),
+	}
+
+	data, err := event.TypedEventData()
+
+	if err != nil {
+		t.Fatalf("TypedEventData() error = %v", err)
+	}
+
+	failed, ok := data.(*RunnerOnFailedEventDataSchema)
+
+	if !ok {
+		t.Fatalf("TypedEventData() type = %T, want *RunnerOnFailedEventDataSchema", data)
+	}
+
+	if failed.Host != "sw1" || failed.Task != "save config" || !failed.Res.Failed || failed.Duration != 1.5 {
+		t.Errorf("TypedEventData() = %+v", failed)
+	}
+
+	if failed.Res.Message() != "timeout value 30 seconds reached" {
+		t.Errorf("Message() = %q", failed.Res.Message())
+	}
+}
+
+func TestJobEventResponseSingleSchema_TypedEventData_Stats(t *testing.T) {
+	event := JobEventResponseSingleSchema{
+		Event:     EventPlaybookOnStats,
+		EventData: json.RawMessage(`{"ok": {"sw1": 3}, "failures": {"sw2": 1}, "dark": {}}`),
+	}
+
+	data, err := event.TypedEventData()
+
+	if err != nil {
+		t.Fatalf("TypedEventData() error = %v", err)
+	}
+
+	stats := data.(*PlaybookOnStatsEventDataSchema)
+
+	if stats.Ok["sw1"] != 3 || stats.Failures["sw2"] != 1 {
+		t.Errorf("TypedEventData() = %+v", stats)
+	}
+}
+
+func TestRunnerResultSchema_Message(t *testing.T) {
+	tests := []struct {
+		name   string
+		result RunnerResultSchema
+		want   string
+	}{
+		{name: "Test Message msg", result: RunnerResultSchema{Msg: "failed"}, want: "failed"},
+		{name: "Test Message list", result: RunnerResultSchema{Msg: []any{"a", "b"}}, want: `["a","b"]`},
+		{name: "Test Message stderr", result: RunnerResultSchema{Stderr: "no route to host\n"}, want: "no route to host"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.result.Message(); got != tt.want {
+				t.Errorf("Message() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
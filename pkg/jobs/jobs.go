@@ -4,12 +4,17 @@ Package jobs provides a way to manipulate jobs for Ansible AAP
 package jobs
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/btr1975/go-ansible-aap-api-client/pkg/connection"
 	"github.com/btr1975/go-ansible-aap-api-client/pkg/dataconversion"
 	"io"
 )
 
+// ErrJobNotCancelable is returned when canceling a job that can not be canceled, for example a finished job
+var ErrJobNotCancelable = errors.New("job can not be canceled")
+
 // Job represents an AAP job
 type Job struct {
 	URI            string
@@ -111,3 +116,168 @@ func (job *Job) GetJobStatus(id int32) (status string, err error) {
 
 	return response.Status, nil
 }
+
+// Hosts a job can be relaunched against
+const (
+	RelaunchHostsAll    = "all"
+	RelaunchHostsFailed = "failed"
+)
+
+// IsFinishedStatus checks if a unified job status is a terminal status
+//
+//	:param status: The status to check
+func IsFinishedStatus(status string) bool {
+	switch status {
+	case "successful", "failed", "error", "canceled", "cancelled":
+		return true
+	default:
+		return false
+	}
+}
+
+// MarshalJSON encodes a relaunch request with the passwords as top level fields
+func (relaunchData JobRelaunchRequestSchema) MarshalJSON() ([]byte, error) {
+	type relaunchRequest JobRelaunchRequestSchema
+
+	data, err := json.Marshal(relaunchRequest(relaunchData))
+
+	if err != nil || len(relaunchData.Passwords) == 0 {
+		return data, err
+	}
+
+	merged := map[string]any{}
+
+	err = json.Unmarshal(data, &merged)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for name, password := range relaunchData.Passwords {
+		merged[name] = password
+	}
+
+	return json.Marshal(merged)
+}
+
+// CanCancelJob checks if a job can be canceled by ID
+//
+//	:param id: The ID of the job to check
+func (job *Job) CanCancelJob(id int32) (canCancel bool, err error) {
+	schemaResponse := JobCancelResponseSchema{}
+
+	uri := fmt.Sprintf("%s%d/cancel/", job.URI, id)
+
+	response, err := job.connection.Get(uri, nil)
+
+	if err != nil {
+		return false, err
+	}
+
+	err = job.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return false, err
+	}
+
+	return schemaResponse.CanCancel, nil
+}
+
+// CancelJob cancels a job by ID
+//
+//	:param id: The ID of the job to cancel
+func (job *Job) CancelJob(id int32) (statusCode int, err error) {
+	canCancel, err := job.CanCancelJob(id)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if !canCancel {
+		return 0, fmt.Errorf("job %d: %w", id, ErrJobNotCancelable)
+	}
+
+	uri := fmt.Sprintf("%s%d/cancel/", job.URI, id)
+
+	response, err := job.connection.Post(uri, []byte("{}"))
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer response.Body.Close()
+
+	return response.StatusCode, nil
+}
+
+// GetRelaunchInfo gets what is needed to relaunch a job by ID
+//
+//	:param id: The ID of the job to relaunch
+func (job *Job) GetRelaunchInfo(id int32) (schemaResponse JobRelaunchInfoSchema, err error) {
+	schemaResponse = JobRelaunchInfoSchema{}
+
+	uri := fmt.Sprintf("%s%d/relaunch/", job.URI, id)
+
+	response, err := job.connection.Get(uri, nil)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = job.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// RelaunchJob relaunches a job by ID
+//
+//	:param id: The ID of the job to relaunch
+//	:param relaunchData: The relaunch options, the hosts to run against, credentials and passwords
+func (job *Job) RelaunchJob(id int32, relaunchData JobRelaunchRequestSchema) (schemaResponse JobResponseSingleSchema, err error) {
+	schemaResponse = JobResponseSingleSchema{}
+
+	if relaunchData.Hosts != "" && relaunchData.Hosts != RelaunchHostsAll && relaunchData.Hosts != RelaunchHostsFailed {
+		return schemaResponse, fmt.Errorf("unsupported relaunch hosts: %s", relaunchData.Hosts)
+	}
+
+	uri := fmt.Sprintf("%s%d/relaunch/", job.URI, id)
+
+	data, err := json.Marshal(relaunchData)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	response, err := job.connection.Post(uri, data)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = job.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// DeleteJob deletes a finished job by ID
+//
+//	:param id: The ID of the job to delete
+func (job *Job) DeleteJob(id int32) (statusCode int, err error) {
+	uri := fmt.Sprintf("%s%d/", job.URI, id)
+
+	response, err := job.connection.Delete(uri, nil)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response.StatusCode, nil
+}
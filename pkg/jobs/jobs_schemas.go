@@ -1,5 +1,7 @@
 package jobs
 
+import "encoding/json"
+
 // JobRelatedResponseSchema is the schema for the related section of a response
 type JobRelatedResponseSchema struct {
 	CreatedBy            string `json:"created_by" yaml:"created_by"`
@@ -23,26 +25,28 @@ type JobRelatedResponseSchema struct {
 
 // JobResponseSingleSchema is the schema for a single job response item
 type JobResponseSingleSchema struct {
-	ID                   int32                    `json:"id" yaml:"id"`
-	Type                 string                   `json:"type" yaml:"type"`
-	URL                  string                   `json:"url" yaml:"url"`
-	Related              JobRelatedResponseSchema `json:"related" yaml:"related"`
-	Created              string                   `json:"created" yaml:"created"`
-	Modified             string                   `json:"modified" yaml:"modified"`
-	Name                 string                   `json:"name" yaml:"name"`
-	Description          string                   `json:"description" yaml:"description"`
-	UnifiedJobTemplate   int32                    `json:"unified_job_template" yaml:"unified_job_template"`
-	LaunchType           string                   `json:"launch_type" yaml:"launch_type"`
-	Status               string                   `json:"status" yaml:"status"`
-	ExecutionEnvironment int32                    `json:"execution_environment" yaml:"execution_environment"`
-	Failed               bool                     `json:"failed" yaml:"failed"`
-	Started              string                   `json:"started" yaml:"started"`
-	Finished             string                   `json:"finished" yaml:"finished"`
-	CanceledOn           string                   `json:"canceled_on" yaml:"canceled_on"`
-	Elapsed              float32                  `json:"elapsed" yaml:"elapsed"`
-	JobExplanation       string                   `json:"job_explanation" yaml:"job_explanation"`
-	ExecutionNode        string                   `json:"execution_node" yaml:"execution_node"`
-	ControllerNode       string                   `json:"controller_node" yaml:"controller_node"`
+	ID                      int32                    `json:"id" yaml:"id"`
+	Type                    string                   `json:"type" yaml:"type"`
+	URL                     string                   `json:"url" yaml:"url"`
+	Related                 JobRelatedResponseSchema `json:"related" yaml:"related"`
+	Created                 string                   `json:"created" yaml:"created"`
+	Modified                string                   `json:"modified" yaml:"modified"`
+	Name                    string                   `json:"name" yaml:"name"`
+	Description             string                   `json:"description" yaml:"description"`
+	UnifiedJobTemplate      int32                    `json:"unified_job_template" yaml:"unified_job_template"`
+	LaunchType              string                   `json:"launch_type" yaml:"launch_type"`
+	Status                  string                   `json:"status" yaml:"status"`
+	ExecutionEnvironment    int32                    `json:"execution_environment" yaml:"execution_environment"`
+	Failed                  bool                     `json:"failed" yaml:"failed"`
+	Started                 string                   `json:"started" yaml:"started"`
+	Finished                string                   `json:"finished" yaml:"finished"`
+	CanceledOn              string                   `json:"canceled_on" yaml:"canceled_on"`
+	Elapsed                 float32                  `json:"elapsed" yaml:"elapsed"`
+	JobExplanation          string                   `json:"job_explanation" yaml:"job_explanation"`
+	ExecutionNode           string                   `json:"execution_node" yaml:"execution_node"`
+	ControllerNode          string                   `json:"controller_node" yaml:"controller_node"`
+	EventProcessingFinished bool                     `json:"event_processing_finished" yaml:"event_processing_finished"`
+	Artifacts               map[string]any           `json:"artifacts" yaml:"artifacts"`
 }
 
 // JobResponseSchema is the schema for an job response
@@ -52,3 +56,64 @@ type JobResponseSchema struct {
 	Previous string                    `json:"previous" yaml:"previous"`
 	Results  []JobResponseSingleSchema `json:"results" yaml:"results"`
 }
+
+// JobCancelResponseSchema is the schema for the response of a GET of the cancel endpoint
+type JobCancelResponseSchema struct {
+	CanCancel bool `json:"can_cancel" yaml:"can_cancel"`
+}
+
+// JobRelaunchRetryCountsSchema is the schema for the number of hosts a relaunch would run against
+type JobRelaunchRetryCountsSchema struct {
+	All    int32 `json:"all" yaml:"all"`
+	Failed int32 `json:"failed" yaml:"failed"`
+}
+
+// JobRelaunchInfoSchema is the schema for the response of a GET of the relaunch endpoint
+type JobRelaunchInfoSchema struct {
+	PasswordsNeededToStart []string                     `json:"passwords_needed_to_start" yaml:"passwords_needed_to_start"`
+	RetryCounts            JobRelaunchRetryCountsSchema `json:"retry_counts" yaml:"retry_counts"`
+}
+
+// JobRelaunchRequestSchema is the schema for a job relaunch request
+//
+// Passwords are sent as top level fields named after the passwords needed to start, for example ssh_password.
+type JobRelaunchRequestSchema struct {
+	Hosts       string            `json:"hosts,omitempty" yaml:"hosts,omitempty"`
+	Credentials []int32           `json:"credentials,omitempty" yaml:"credentials,omitempty"`
+	Passwords   map[string]string `json:"-" yaml:"-"`
+}
+
+// JobEventResponseSingleSchema is the schema for a single job event response item
+type JobEventResponseSingleSchema struct {
+	ID         int32           `json:"id" yaml:"id"`
+	Type       string          `json:"type" yaml:"type"`
+	URL        string          `json:"url" yaml:"url"`
+	Created    string          `json:"created" yaml:"created"`
+	Modified   string          `json:"modified" yaml:"modified"`
+	Job        int32           `json:"job" yaml:"job"`
+	Event      string          `json:"event" yaml:"event"`
+	Counter    int32           `json:"counter" yaml:"counter"`
+	EventData  json.RawMessage `json:"event_data" yaml:"event_data"`
+	Failed     bool            `json:"failed" yaml:"failed"`
+	Changed    bool            `json:"changed" yaml:"changed"`
+	UUID       string          `json:"uuid" yaml:"uuid"`
+	ParentUUID string          `json:"parent_uuid" yaml:"parent_uuid"`
+	Host       int32           `json:"host" yaml:"host"`
+	HostName   string          `json:"host_name" yaml:"host_name"`
+	Playbook   string          `json:"playbook" yaml:"playbook"`
+	Play       string          `json:"play" yaml:"play"`
+	Task       string          `json:"task" yaml:"task"`
+	Role       string          `json:"role" yaml:"role"`
+	StdOut     string          `json:"stdout" yaml:"stdout"`
+	StartLine  int32           `json:"start_line" yaml:"start_line"`
+	EndLine    int32           `json:"end_line" yaml:"end_line"`
+	Verbosity  int32           `json:"verbosity" yaml:"verbosity"`
+}
+
+// JobEventResponseSchema is the schema for a job events response
+type JobEventResponseSchema struct {
+	Count    int32                          `json:"count" yaml:"count"`
+	Next     string                         `json:"next" yaml:"next"`
+	Previous string                         `json:"previous" yaml:"previous"`
+	Results  []JobEventResponseSingleSchema `json:"results" yaml:"results"`
+}
@@ -0,0 +1,199 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// Standard output formats supported when tailing a job
+const (
+	StdOutFormatTxt  = "txt"
+	StdOutFormatANSI = "ansi"
+	StdOutFormatJSON = "json"
+)
+
+// ansiEscape matches ANSI escape sequences in the standard output of an event
+var ansiEscape = regexp.MustCompile(`\x1b\[[0-9;?]*[A-Za-z]`)
+
+// TailOptions are the options used to tail the standard output of a job
+type TailOptions struct {
+	// Format is the format to write the output in ("txt", "ansi", "json"), defaults to "txt"
+	Format string
+	// PollInterval is the time to wait for new output while the job is running, defaults to 2 seconds
+	PollInterval time.Duration
+	// PageSize is the number of events requested at a time, defaults to 200
+	PageSize int
+	// MaxRetries is the number of consecutive failed requests tolerated before giving up, defaults to 5
+	MaxRetries int
+	// StartCounter resumes the output after the event with this counter, 0 starts from the beginning
+	StartCounter int32
+}
+
+// withDefaults returns the options with the defaults filled in
+func (options TailOptions) withDefaults() TailOptions {
+	if options.Format == "" {
+		options.Format = StdOutFormatTxt
+	}
+
+	if options.PollInterval <= 0 {
+		options.PollInterval = 2 * time.Second
+	}
+
+	if options.PageSize <= 0 {
+		options.PageSize = 200
+	}
+
+	if options.MaxRetries <= 0 {
+		options.MaxRetries = 5
+	}
+
+	return options
+}
+
+// GetJobEventsAfter gets a page of the events of a job ordered by counter
+//
+//	:param id: The ID of the job
+//	:param counter: Only events with a greater counter are returned
+//	:param pageSize: The maximum number of events to return
+func (job *Job) GetJobEventsAfter(id int32, counter int32, pageSize int) (schemaResponse JobEventResponseSchema, err error) {
+	schemaResponse = JobEventResponseSchema{}
+
+	params := map[string]string{
+		"counter__gt": strconv.Itoa(int(counter)),
+		"order_by":    "counter",
+		"page_size":   strconv.Itoa(pageSize),
+	}
+
+	uri := fmt.Sprintf("%s%d/job_events/", job.URI, id)
+
+	response, err := job.connection.Get(uri, params)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = job.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// TailJobStdOut follows the standard output of a job into a writer until the job is finished
+//
+// The output is read from the job events in counter order, so a running job can be followed and the output
+// resumes where it left off after a failed request. The returned counter can be passed as
+// TailOptions.StartCounter to resume in a later call.
+//
+//	:param ctx: The context used to stop tailing
+//	:param id: The ID of the job to tail
+//	:param writer: The writer to write the output to
+//	:param options: The tail options
+func (job *Job) TailJobStdOut(ctx context.Context, id int32, writer io.Writer, options TailOptions) (lastCounter int32, err error) {
+	options = options.withDefaults()
+	lastCounter = options.StartCounter
+	retries := 0
+
+	switch options.Format {
+	case StdOutFormatTxt, StdOutFormatANSI, StdOutFormatJSON:
+	default:
+		return lastCounter, fmt.Errorf("unsupported stdout format: %s", options.Format)
+	}
+
+	for {
+		if ctx.Err() != nil {
+			return lastCounter, ctx.Err()
+		}
+
+		jobData, err := job.GetJob(id)
+
+		if err == nil {
+			finished := IsFinishedStatus(jobData.Status) && jobData.EventProcessingFinished
+
+			var events JobEventResponseSchema
+			events, err = job.GetJobEventsAfter(id, lastCounter, options.PageSize)
+
+			if err == nil {
+				retries = 0
+
+				var written int
+				lastCounter, written, err = writeEvents(writer, events.Results, lastCounter, finished, options.Format)
+
+				if err != nil {
+					return lastCounter, err
+				}
+
+				if finished && events.Next == "" && written == len(events.Results) {
+					return lastCounter, nil
+				}
+
+				if written == options.PageSize {
+					continue
+				}
+			}
+		}
+
+		if err != nil {
+			retries++
+
+			if retries > options.MaxRetries {
+				return lastCounter, fmt.Errorf("error tailing job %d after event %d: %w", id, lastCounter, err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return lastCounter, ctx.Err()
+		case <-time.After(options.PollInterval):
+		}
+	}
+}
+
+// writeEvents writes the events that follow the last written counter without a gap
+//
+//	:param writer: The writer to write the output to
+//	:param events: The events ordered by counter
+//	:param lastCounter: The counter of the last written event
+//	:param skipGaps: Whether missing counters are skipped instead of waited for
+//	:param outputFormat: The format to write the output in
+func writeEvents(writer io.Writer, events []JobEventResponseSingleSchema, lastCounter int32, skipGaps bool, outputFormat string) (newCounter int32, written int, err error) {
+	for _, event := range events {
+		if event.Counter <= lastCounter {
+			written++
+			continue
+		}
+
+		if event.Counter != lastCounter+1 && !skipGaps {
+			break
+		}
+
+		switch outputFormat {
+		case StdOutFormatJSON:
+			err = json.NewEncoder(writer).Encode(event)
+		case StdOutFormatANSI:
+			if event.StdOut != "" {
+				_, err = io.WriteString(writer, event.StdOut+"\n")
+			}
+		default:
+			if event.StdOut != "" {
+				_, err = io.WriteString(writer, ansiEscape.ReplaceAllString(event.StdOut, "")+"\n")
+			}
+		}
+
+		if err != nil {
+			return lastCounter, written, err
+		}
+
+		lastCounter = event.Counter
+		written++
+	}
+
+	return lastCounter, written, nil
+}
@@ -0,0 +1,116 @@
+package jobs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/btr1975/go-ansible-aap-api-client/internal/fakeconnection"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newFakeTailConnection serves job 1 whose events arrive over several polls, the first events request fails
+func newFakeTailConnection() *fakeconnection.Connection {
+	events := []JobEventResponseSingleSchema{
+		{Counter: 1, Event: "playbook_on_start"},
+		{Counter: 2, Event: "playbook_on_play_start", StdOut: "\x1b[0;32mPLAY [all]\x1b[0m"},
+		{Counter: 3, Event: "playbook_on_task_start", StdOut: "TASK [show version]"},
+		{Counter: 4, Event: "runner_on_ok", StdOut: "\x1b[0;32mok: [sw1]\x1b[0m"},
+		{Counter: 5, Event: "playbook_on_stats", StdOut: "PLAY RECAP"},
+	}
+	polls := 0
+	failOnce := true
+
+	return fakeconnection.New().
+		Handle(http.MethodGet, "jobs/1/job_events/", func(request fakeconnection.Request) (*http.Response, error) {
+			if failOnce {
+				failOnce = false
+				return nil, errors.New("connection reset by peer")
+			}
+
+			counter, _ := strconv.Atoi(request.Params["counter__gt"])
+			visible := map[int]int{1: 2, 2: 4}[polls]
+
+			if visible == 0 {
+				visible = len(events)
+			}
+
+			results := []JobEventResponseSingleSchema{}
+
+			for _, event := range events[:visible] {
+				if int(event.Counter) > counter {
+					results = append(results, event)
+				}
+			}
+
+			return fakeconnection.JSON(JobEventResponseSchema{Count: int32(len(results)), Results: results})
+		}).
+		Handle(http.MethodGet, "jobs/1/", func(fakeconnection.Request) (*http.Response, error) {
+			polls++
+
+			if polls < 3 {
+				return fakeconnection.JSON(JobResponseSingleSchema{ID: 1, Status: "running"})
+			}
+
+			return fakeconnection.JSON(JobResponseSingleSchema{ID: 1, Status: "successful", EventProcessingFinished: true})
+		})
+}
+
+func TestJob_TailJobStdOut(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		want   string
+	}{
+		{
+			name:   "Test TailJobStdOut txt",
+			format: StdOutFormatTxt,
+			want:   "PLAY [all]\nTASK [show version]\nok: [sw1]\nPLAY RECAP\n",
+		},
+		{
+			name:   "Test TailJobStdOut ansi",
+			format: StdOutFormatANSI,
+			want:   "\x1b[0;32mPLAY [all]\x1b[0m\nTASK [show version]\n\x1b[0;32mok: [sw1]\x1b[0m\nPLAY RECAP\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := NewJob(newFakeTailConnection())
+			output := &bytes.Buffer{}
+
+			lastCounter, err := job.TailJobStdOut(context.Background(), 1, output, TailOptions{Format: tt.format, PollInterval: time.Millisecond})
+
+			if err != nil {
+				t.Fatalf("Job.TailJobStdOut() error = %v", err)
+			}
+
+			if lastCounter != 5 {
+				t.Errorf("Job.TailJobStdOut() lastCounter = %d, want 5", lastCounter)
+			}
+
+			if output.String() != tt.want {
+				t.Errorf("Job.TailJobStdOut() output = %q, want %q", output.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestJob_TailJobStdOut_Resume(t *testing.T) {
+	job := NewJob(newFakeTailConnection())
+	output := &bytes.Buffer{}
+
+	_, err := job.TailJobStdOut(context.Background(), 1, output, TailOptions{Format: StdOutFormatJSON, PollInterval: time.Millisecond, StartCounter: 3})
+
+	if err != nil {
+		t.Fatalf("Job.TailJobStdOut() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+
+	if len(lines) != 2 || !strings.Contains(lines[0], `"counter":4`) || !strings.Contains(lines[1], `"counter":5`) {
+		t.Errorf("Job.TailJobStdOut() output = %q, want events 4 and 5", output.String())
+	}
+}
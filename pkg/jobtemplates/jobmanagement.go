@@ -1,22 +1,33 @@
 package jobtemplates
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"github.com/btr1975/go-ansible-aap-api-client/pkg/connection"
 	"github.com/btr1975/go-ansible-aap-api-client/pkg/inventories"
 	"github.com/btr1975/go-ansible-aap-api-client/pkg/jobs"
-	"time"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/polling"
+	"sync"
 )
 
+// ErrAborted is returned when launching a job after the context given to AbortOnContext is done
+var ErrAborted = errors.New("job management was aborted")
+
 // JobManagement represents an AAP job management object
 type JobManagement struct {
 	jobTemplate     *JobTemplate
 	job             *jobs.Job
 	jobID           int32
+	jobIDMutex      sync.Mutex
+	aborted         bool
 	jobTemplateName string
 	jobTemplateID   int32
 	inventoryName   string
 	inventoryID     int32
+	pollOptions     PollOptions
+	observers       []StatusObserver
+	logger          Logger
 }
 
 // NewJobManagement creates a new job management instance
@@ -52,6 +63,10 @@ func NewJobManagement(basicConnection connection.BasicConnection, jobTemplateNam
 //
 //	:param launchData: The launch data
 func (jobManagement *JobManagement) Run(launchData JobTemplateSimpleRequestSchema) (err error) {
+	if jobManagement.isAborted() {
+		return ErrAborted
+	}
+
 	launchData.Inventory = jobManagement.inventoryID
 
 	jobData, err := jobManagement.jobTemplate.LaunchJobTemplate(jobManagement.jobTemplateID, launchData)
@@ -60,10 +75,29 @@ func (jobManagement *JobManagement) Run(launchData JobTemplateSimpleRequestSchem
 		return err
 	}
 
-	jobManagement.jobID = jobData.ID
+	return jobManagement.setJobID(jobData.ID)
 
-	return nil
+}
 
+// RunLaunchRequest runs a job with a full launch request, the inventory defaults to the one of the job management
+//
+//	:param launchData: The launch data
+func (jobManagement *JobManagement) RunLaunchRequest(launchData JobTemplateLaunchRequestSchema) (err error) {
+	if jobManagement.isAborted() {
+		return ErrAborted
+	}
+
+	if launchData.Inventory == 0 {
+		launchData.Inventory = jobManagement.inventoryID
+	}
+
+	jobData, err := jobManagement.jobTemplate.LaunchJobTemplate(jobManagement.jobTemplateID, launchData)
+
+	if err != nil {
+		return err
+	}
+
+	return jobManagement.setJobID(jobData.ID)
 }
 
 // PollCompletion runs a job and polls for completion
@@ -71,40 +105,154 @@ func (jobManagement *JobManagement) Run(launchData JobTemplateSimpleRequestSchem
 //	:param printStatus: Whether to print the status
 //	:param launchData: The launch data
 func (jobManagement *JobManagement) PollCompletion(printStatus bool, launchData JobTemplateSimpleRequestSchema) (jobStatus string, err error) {
-	jobStatus = "new"
-
-	if jobManagement.jobID == 0 {
+	if jobManagement.getJobID() == 0 {
 
 		err = jobManagement.Run(launchData)
 
 		if err != nil {
-			return jobStatus, err
+			return "new", err
 		}
 	}
 
-	if printStatus {
-		fmt.Printf("Polling Job ID %d current status %s\n", jobManagement.jobID, jobStatus)
-	}
+	return jobManagement.poll(printStatus)
+}
 
-	for jobStatus != "successful" && jobStatus != "failed" && jobStatus != "error" && jobStatus != "cancelled" {
-		currentStatus, err := jobManagement.job.GetJobStatus(jobManagement.jobID)
+// PollCompletionLaunchRequest runs a job with a full launch request and polls for completion
+//
+//	:param printStatus: Whether to print the status
+//	:param launchData: The launch data
+func (jobManagement *JobManagement) PollCompletionLaunchRequest(printStatus bool, launchData JobTemplateLaunchRequestSchema) (jobStatus string, err error) {
+	if jobManagement.getJobID() == 0 {
+
+		err = jobManagement.RunLaunchRequest(launchData)
 
 		if err != nil {
-			return jobStatus, err
+			return "new", err
 		}
+	}
 
-		jobStatus = currentStatus
+	return jobManagement.poll(printStatus)
+}
 
-		if printStatus {
-			fmt.Printf("Polling Job ID %d current status %s\n", jobManagement.jobID, jobStatus)
-		}
+// poll polls the launched job for completion
+//
+// Progress is reported to the logger set with SetLogger, or printed to stdout when printStatus is true and no
+// logger is set.
+//
+//	:param printStatus: Whether to print the status
+func (jobManagement *JobManagement) poll(printStatus bool) (jobStatus string, err error) {
+	logger := jobManagement.logger
+
+	if logger == nil && printStatus {
+		logger = polling.StdoutLogger{}
+	}
+
+	return jobManagement.waitForJob(context.Background(), logger)
+}
+
+// setJobID sets the ID of the launched job and cancels it when the job management was aborted during the launch
+//
+//	:param jobID: The ID of the launched job
+func (jobManagement *JobManagement) setJobID(jobID int32) (err error) {
+	jobManagement.jobIDMutex.Lock()
+	jobManagement.jobID = jobID
+	aborted := jobManagement.aborted
+	jobManagement.jobIDMutex.Unlock()
+
+	if !aborted {
+		return nil
+	}
+
+	err = jobManagement.cancelJob(jobID)
+
+	if err != nil {
+		return fmt.Errorf("%w, canceling job %d: %w", ErrAborted, jobID, err)
+	}
+
+	return fmt.Errorf("%w, job %d was canceled", ErrAborted, jobID)
+}
+
+// setAborted marks the job management as aborted and gets the ID of the launched job, 0 when no job was launched
+func (jobManagement *JobManagement) setAborted() int32 {
+	jobManagement.jobIDMutex.Lock()
+	defer jobManagement.jobIDMutex.Unlock()
+
+	jobManagement.aborted = true
+
+	return jobManagement.jobID
+}
+
+// isAborted checks if the context given to AbortOnContext is done
+func (jobManagement *JobManagement) isAborted() bool {
+	jobManagement.jobIDMutex.Lock()
+	defer jobManagement.jobIDMutex.Unlock()
+
+	return jobManagement.aborted
+}
+
+// getJobID gets the ID of the launched job, 0 when no job was launched
+func (jobManagement *JobManagement) getJobID() int32 {
+	jobManagement.jobIDMutex.Lock()
+	defer jobManagement.jobIDMutex.Unlock()
 
-		time.Sleep(5 * time.Second)
+	return jobManagement.jobID
+}
+
+// Abort cancels the launched job if it is still running
+func (jobManagement *JobManagement) Abort() (err error) {
+	jobID := jobManagement.getJobID()
+
+	if jobID == 0 {
+		return errors.New("no job has been launched")
 	}
 
-	if printStatus {
-		fmt.Printf("Polling Job ID %d completed status %s\n", jobManagement.jobID, jobStatus)
+	return jobManagement.cancelJob(jobID)
+}
+
+// cancelJob cancels a job, a job that can no longer be canceled is not an error
+//
+//	:param jobID: The ID of the job to cancel
+func (jobManagement *JobManagement) cancelJob(jobID int32) (err error) {
+	_, err = jobManagement.job.CancelJob(jobID)
+
+	if errors.Is(err, jobs.ErrJobNotCancelable) {
+		return nil
 	}
 
-	return jobStatus, nil
+	return err
+}
+
+// AbortOnContext cancels the launched job when the context is done, for example a context
+// from signal.NotifyContext so the job is canceled when the pipeline is interrupted
+//
+// When the context is done before a job is launched, Run refuses to launch and returns ErrAborted, and a job whose
+// launch was in flight is canceled as soon as it is created.
+//
+//	:param ctx: The context to watch
+//	:param onAbort: An optional function called with the result of the abort
+func (jobManagement *JobManagement) AbortOnContext(ctx context.Context, onAbort func(err error)) (stop func()) {
+	stopped := make(chan struct{})
+	var once sync.Once
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			var err error
+
+			if jobID := jobManagement.setAborted(); jobID != 0 {
+				err = jobManagement.cancelJob(jobID)
+			}
+
+			if onAbort != nil {
+				onAbort(err)
+			}
+		case <-stopped:
+		}
+	}()
+
+	return func() {
+		once.Do(func() {
+			close(stopped)
+		})
+	}
 }
@@ -0,0 +1,105 @@
+package jobtemplates
+
+import (
+	"context"
+	"errors"
+	"github.com/btr1975/go-ansible-aap-api-client/internal/fakeconnection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/jobs"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+// newFakeAbortConnection launches job 9 from job template 3, onLaunch is called while the launch is in flight
+func newFakeAbortConnection(canCancel bool, onLaunch *func()) *fakeconnection.Connection {
+	return fakeconnection.New().
+		Handle(http.MethodPost, "job_templates/3/launch/", func(fakeconnection.Request) (*http.Response, error) {
+			if *onLaunch != nil {
+				(*onLaunch)()
+			}
+
+			return fakeconnection.JSON(JobTemplateResponseSingleSchema{ID: 9})
+		}).
+		HandleJSON(http.MethodGet, "jobs/9/cancel/", map[string]any{"can_cancel": canCancel}).
+		HandleJSON(http.MethodPost, "jobs/9/cancel/", map[string]any{})
+}
+
+func TestJobManagement_AbortOnContext(t *testing.T) {
+	launch := "POST job_templates/3/launch/"
+	cancelJob := []string{"GET jobs/9/cancel/", "POST jobs/9/cancel/"}
+
+	tests := []struct {
+		name         string
+		cancelBefore bool
+		cancelDuring bool
+		canCancel    bool
+		wantErr      error
+		wantRequests []string
+	}{
+		{
+			name:         "Test AbortOnContext before launch refuses to launch",
+			cancelBefore: true,
+			canCancel:    true,
+			wantErr:      ErrAborted,
+			wantRequests: []string{},
+		},
+		{
+			name:         "Test AbortOnContext during launch cancels the launched job",
+			cancelDuring: true,
+			canCancel:    true,
+			wantErr:      ErrAborted,
+			wantRequests: append([]string{launch}, cancelJob...),
+		},
+		{
+			name:         "Test AbortOnContext after launch cancels the job",
+			canCancel:    true,
+			wantRequests: append([]string{launch}, cancelJob...),
+		},
+		{
+			name:         "Test AbortOnContext after the job finished",
+			wantRequests: []string{launch, cancelJob[0]},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var onLaunch func()
+			connection := newFakeAbortConnection(tt.canCancel, &onLaunch)
+			jobManagement := &JobManagement{jobTemplate: NewJobTemplate(connection), job: jobs.NewJob(connection), jobTemplateID: 3}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			aborted := make(chan error, 1)
+			stop := jobManagement.AbortOnContext(ctx, func(err error) { aborted <- err })
+			defer stop()
+
+			abort := func() {
+				cancel()
+
+				if err := <-aborted; err != nil {
+					t.Errorf("AbortOnContext() abort error = %v", err)
+				}
+			}
+
+			if tt.cancelBefore {
+				abort()
+			}
+
+			if tt.cancelDuring {
+				onLaunch = abort
+			}
+
+			err := jobManagement.Run(JobTemplateSimpleRequestSchema{})
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("JobManagement.Run() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if !tt.cancelBefore && !tt.cancelDuring {
+				abort()
+			}
+
+			if requests := connection.Calls(); !slices.Equal(requests, tt.wantRequests) {
+				t.Errorf("JobManagement requests = %v, want %v", requests, tt.wantRequests)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package jobtemplates
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/jobs"
+	"sync"
+	"time"
+)
+
+// JobFailedError is returned when a job finishes with a failed, error or canceled status
+type JobFailedError struct {
+	JobID          int32
+	Status         string
+	JobExplanation string
+}
+
+// Error gets the error message
+func (jobError *JobFailedError) Error() string {
+	if jobError.JobExplanation != "" {
+		return fmt.Sprintf("job %d finished with status %s: %s", jobError.JobID, jobError.Status, jobError.JobExplanation)
+	}
+
+	return fmt.Sprintf("job %d finished with status %s", jobError.JobID, jobError.Status)
+}
+
+// JobResult is the result of a finished job
+type JobResult struct {
+	JobID          int32
+	Status         string
+	Job            jobs.JobResponseSingleSchema
+	Elapsed        time.Duration
+	JobExplanation string
+	HostSummaries  []jobs.JobHostSummaryResponseSingleSchema
+	// Artifacts are the artifacts of the job, set with set_stats
+	Artifacts   map[string]any
+	job         *jobs.Job
+	stdOutMutex sync.Mutex
+	stdOut      map[string]string
+}
+
+// StdOut gets the standard output of the job, it is fetched on the first call for each format
+//
+//	:param outputFormat: The format of the output (txt, ansi, json, html)
+func (result *JobResult) StdOut(outputFormat string) (stdOut string, err error) {
+	result.stdOutMutex.Lock()
+	defer result.stdOutMutex.Unlock()
+
+	if stdOut, ok := result.stdOut[outputFormat]; ok {
+		return stdOut, nil
+	}
+
+	stdOut, err = result.job.GetJobStdOut(result.JobID, outputFormat)
+
+	if err != nil {
+		return "", err
+	}
+
+	if result.stdOut == nil {
+		result.stdOut = map[string]string{}
+	}
+
+	result.stdOut[outputFormat] = stdOut
+
+	return stdOut, nil
+}
+
+// JobID gets the ID of the launched job, 0 when no job was launched
+func (jobManagement *JobManagement) JobID() int32 {
+	return jobManagement.getJobID()
+}
+
+// WaitForResult polls the launched job until it is finished and gets its result
+//
+// When the job finishes with a failed, error or canceled status the result is returned with a *JobFailedError.
+//
+//	:param ctx: The context used to stop waiting
+func (jobManagement *JobManagement) WaitForResult(ctx context.Context) (result *JobResult, err error) {
+	_, err = jobManagement.Wait(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	jobID := jobManagement.getJobID()
+
+	jobData, err := jobManagement.job.GetJob(jobID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	hostSummaries, err := jobManagement.job.GetJobHostSummaries(jobID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result = &JobResult{
+		JobID:          jobID,
+		Status:         jobData.Status,
+		Job:            jobData,
+		Elapsed:        time.Duration(float64(jobData.Elapsed) * float64(time.Second)),
+		JobExplanation: jobData.JobExplanation,
+		HostSummaries:  hostSummaries,
+		Artifacts:      jobData.Artifacts,
+		job:            jobManagement.job,
+	}
+
+	switch jobData.Status {
+	case "failed", "error", "canceled", "cancelled":
+		return result, &JobFailedError{JobID: jobID, Status: jobData.Status, JobExplanation: jobData.JobExplanation}
+	}
+
+	return result, nil
+}
+
+// PollCompletionResult runs a job with a full launch request if none was launched and gets its result
+//
+//	:param ctx: The context used to stop waiting
+//	:param launchData: The launch data
+func (jobManagement *JobManagement) PollCompletionResult(ctx context.Context, launchData JobTemplateLaunchRequestSchema) (result *JobResult, err error) {
+	if jobManagement.getJobID() == 0 {
+		err = jobManagement.RunLaunchRequest(launchData)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return jobManagement.WaitForResult(ctx)
+}
+
+// IsJobFailed checks if an error is a *JobFailedError
+//
+//	:param err: The error to check
+func IsJobFailed(err error) bool {
+	var jobError *JobFailedError
+
+	return errors.As(err, &jobError)
+}
@@ -0,0 +1,52 @@
+package jobtemplates
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestJobManagement_WaitForResult(t *testing.T) {
+	tests := []struct {
+		name      string
+		statuses  []string
+		wantError bool
+	}{
+		{
+			name:     "Test WaitForResult successful",
+			statuses: []string{"running", "successful"},
+		},
+		{
+			name:      "Test WaitForResult failed",
+			statuses:  []string{"running", "failed"},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobManagement := newTestJobManagement(tt.statuses...)
+			jobManagement.SetPollOptions(PollOptions{Interval: time.Millisecond})
+
+			result, err := jobManagement.WaitForResult(context.Background())
+
+			var jobError *JobFailedError
+
+			if errors.As(err, &jobError) != tt.wantError {
+				t.Fatalf("JobManagement.WaitForResult() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if tt.wantError && (jobError.JobID != 5 || jobError.Status != "failed" || jobError.JobExplanation != "Previous Task Failed") {
+				t.Errorf("JobManagement.WaitForResult() error = %+v", jobError)
+			}
+
+			if result == nil || result.JobID != 5 || result.Elapsed != 1500*time.Millisecond || result.Artifacts["backup_path"] != "/tmp/sw1.cfg" {
+				t.Fatalf("JobManagement.WaitForResult() result = %+v", result)
+			}
+
+			if len(result.HostSummaries) != 1 || result.HostSummaries[0].HostName != "sw1" {
+				t.Errorf("JobManagement.WaitForResult() host summaries = %+v", result.HostSummaries)
+			}
+		})
+	}
+}
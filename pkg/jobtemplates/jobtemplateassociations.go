@@ -0,0 +1,185 @@
+package jobtemplates
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/common"
+)
+
+// Notification events a notification template can be attached to a job template for
+const (
+	NotificationEventStarted = "started"
+	NotificationEventSuccess = "success"
+	NotificationEventError   = "error"
+)
+
+// GetRelated gets a related list of a job template by ID
+//
+//	:param id: The ID of the job template
+//	:param related: The name of the related list, for example "credentials" or "labels"
+func (jobTemplate *JobTemplate) GetRelated(id int32, related string) (schemaResponse RelatedResponseSchema, err error) {
+	schemaResponse = RelatedResponseSchema{}
+
+	uri := fmt.Sprintf("%s%d/%s/", jobTemplate.URI, id, related)
+
+	response, err := jobTemplate.connection.Get(uri, nil)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = jobTemplate.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// associate associates or disassociates an object on a related list of a job template
+//
+//	:param id: The ID of the job template
+//	:param related: The name of the related list
+//	:param relatedID: The ID of the object to associate
+//	:param disassociate: Whether to disassociate instead of associate
+func (jobTemplate *JobTemplate) associate(id int32, related string, relatedID int32, disassociate bool) (statusCode int, err error) {
+	uri := fmt.Sprintf("%s%d/%s/", jobTemplate.URI, id, related)
+
+	return common.Associate(jobTemplate.connection, uri, relatedID, disassociate)
+}
+
+// GetCredentials gets the credentials of a job template by ID, credentials.Credential.AttachToJobTemplate and
+// credentials.Credential.DetachFromJobTemplate change them
+//
+//	:param id: The ID of the job template
+func (jobTemplate *JobTemplate) GetCredentials(id int32) (schemaResponse RelatedResponseSchema, err error) {
+	return jobTemplate.GetRelated(id, "credentials")
+}
+
+// GetLabels gets the labels of a job template by ID
+//
+//	:param id: The ID of the job template
+func (jobTemplate *JobTemplate) GetLabels(id int32) (schemaResponse RelatedResponseSchema, err error) {
+	return jobTemplate.GetRelated(id, "labels")
+}
+
+// AddLabel adds a label to a job template, the label is created if it does not exist
+//
+//	:param id: The ID of the job template
+//	:param name: The name of the label
+//	:param organizationID: The ID of the organization the label belongs to
+func (jobTemplate *JobTemplate) AddLabel(id int32, name string, organizationID int32) (statusCode int, err error) {
+	uri := fmt.Sprintf("%s%d/labels/", jobTemplate.URI, id)
+
+	data, err := json.Marshal(LabelRequestSchema{Name: name, Organization: organizationID})
+
+	if err != nil {
+		return 0, err
+	}
+
+	response, err := jobTemplate.connection.Post(uri, data)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer response.Body.Close()
+
+	return response.StatusCode, nil
+}
+
+// AssociateLabel associates an existing label with a job template
+//
+//	:param id: The ID of the job template
+//	:param labelID: The ID of the label to associate
+func (jobTemplate *JobTemplate) AssociateLabel(id int32, labelID int32) (statusCode int, err error) {
+	return jobTemplate.associate(id, "labels", labelID, false)
+}
+
+// DisassociateLabel disassociates a label from a job template
+//
+//	:param id: The ID of the job template
+//	:param labelID: The ID of the label to disassociate
+func (jobTemplate *JobTemplate) DisassociateLabel(id int32, labelID int32) (statusCode int, err error) {
+	return jobTemplate.associate(id, "labels", labelID, true)
+}
+
+// GetInstanceGroups gets the instance groups of a job template by ID
+//
+//	:param id: The ID of the job template
+func (jobTemplate *JobTemplate) GetInstanceGroups(id int32) (schemaResponse RelatedResponseSchema, err error) {
+	return jobTemplate.GetRelated(id, "instance_groups")
+}
+
+// AssociateInstanceGroup associates an instance group with a job template
+//
+//	:param id: The ID of the job template
+//	:param instanceGroupID: The ID of the instance group to associate
+func (jobTemplate *JobTemplate) AssociateInstanceGroup(id int32, instanceGroupID int32) (statusCode int, err error) {
+	return jobTemplate.associate(id, "instance_groups", instanceGroupID, false)
+}
+
+// DisassociateInstanceGroup disassociates an instance group from a job template
+//
+//	:param id: The ID of the job template
+//	:param instanceGroupID: The ID of the instance group to disassociate
+func (jobTemplate *JobTemplate) DisassociateInstanceGroup(id int32, instanceGroupID int32) (statusCode int, err error) {
+	return jobTemplate.associate(id, "instance_groups", instanceGroupID, true)
+}
+
+// notificationRelated gets the related list name for a notification event
+//
+//	:param event: The notification event ("started", "success", "error")
+func notificationRelated(event string) (related string, err error) {
+	switch event {
+	case NotificationEventStarted, NotificationEventSuccess, NotificationEventError:
+		return fmt.Sprintf("notification_templates_%s", event), nil
+	default:
+		return "", fmt.Errorf("unsupported notification event: %s", event)
+	}
+}
+
+// GetNotificationTemplates gets the notification templates of a job template for an event
+//
+//	:param id: The ID of the job template
+//	:param event: The notification event ("started", "success", "error")
+func (jobTemplate *JobTemplate) GetNotificationTemplates(id int32, event string) (schemaResponse RelatedResponseSchema, err error) {
+	related, err := notificationRelated(event)
+
+	if err != nil {
+		return RelatedResponseSchema{}, err
+	}
+
+	return jobTemplate.GetRelated(id, related)
+}
+
+// AssociateNotificationTemplate associates a notification template with a job template for an event
+//
+//	:param id: The ID of the job template
+//	:param event: The notification event ("started", "success", "error")
+//	:param notificationTemplateID: The ID of the notification template to associate
+func (jobTemplate *JobTemplate) AssociateNotificationTemplate(id int32, event string, notificationTemplateID int32) (statusCode int, err error) {
+	related, err := notificationRelated(event)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return jobTemplate.associate(id, related, notificationTemplateID, false)
+}
+
+// DisassociateNotificationTemplate disassociates a notification template from a job template for an event
+//
+//	:param id: The ID of the job template
+//	:param event: The notification event ("started", "success", "error")
+//	:param notificationTemplateID: The ID of the notification template to disassociate
+func (jobTemplate *JobTemplate) DisassociateNotificationTemplate(id int32, event string, notificationTemplateID int32) (statusCode int, err error) {
+	related, err := notificationRelated(event)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return jobTemplate.associate(id, related, notificationTemplateID, true)
+}
@@ -120,3 +120,150 @@ func (jobTemplate *JobTemplate) LaunchJobTemplate(id int32, launchData any) (sch
 
 	return schemaResponse, nil
 }
+
+// GetJobTemplateByID gets a job template by ID
+//
+//	:param id: The ID of the job template to get
+func (jobTemplate *JobTemplate) GetJobTemplateByID(id int32) (schemaResponse JobTemplateResponseSingleSchema, err error) {
+	schemaResponse = JobTemplateResponseSingleSchema{}
+
+	uri := fmt.Sprintf("%s%d/", jobTemplate.URI, id)
+
+	response, err := jobTemplate.connection.Get(uri, nil)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = jobTemplate.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// CreateJobTemplate creates a new job template
+//
+//	:param jobTemplateRequest: The job template request schema to use
+func (jobTemplate *JobTemplate) CreateJobTemplate(jobTemplateRequest JobTemplateRequestSchema) (schemaResponse JobTemplateResponseSingleSchema, err error) {
+	schemaResponse = JobTemplateResponseSingleSchema{}
+
+	data, err := json.Marshal(jobTemplateRequest)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	response, err := jobTemplate.connection.Post(jobTemplate.URI, data)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = jobTemplate.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// UpdateJobTemplate updates the fields of a job template by ID that are set in the request
+//
+//	:param id: The ID of the job template to update
+//	:param jobTemplateRequest: The job template update request schema to use
+func (jobTemplate *JobTemplate) UpdateJobTemplate(id int32, jobTemplateRequest JobTemplateUpdateRequestSchema) (schemaResponse JobTemplateResponseSingleSchema, err error) {
+	data, err := json.Marshal(jobTemplateRequest)
+
+	if err != nil {
+		return JobTemplateResponseSingleSchema{}, err
+	}
+
+	return jobTemplate.patchJobTemplate(id, data)
+}
+
+// ClearExecutionEnvironment removes the execution environment of a job template by ID
+//
+//	:param id: The ID of the job template
+func (jobTemplate *JobTemplate) ClearExecutionEnvironment(id int32) (schemaResponse JobTemplateResponseSingleSchema, err error) {
+	return jobTemplate.patchJobTemplate(id, []byte(`{"execution_environment": null}`))
+}
+
+// ClearWebhookCredential removes the webhook credential of a job template by ID
+//
+//	:param id: The ID of the job template
+func (jobTemplate *JobTemplate) ClearWebhookCredential(id int32) (schemaResponse JobTemplateResponseSingleSchema, err error) {
+	return jobTemplate.patchJobTemplate(id, []byte(`{"webhook_credential": null}`))
+}
+
+// patchJobTemplate patches a job template by ID
+//
+//	:param id: The ID of the job template to patch
+//	:param data: The JSON data to send
+func (jobTemplate *JobTemplate) patchJobTemplate(id int32, data []byte) (schemaResponse JobTemplateResponseSingleSchema, err error) {
+	schemaResponse = JobTemplateResponseSingleSchema{}
+
+	uri := fmt.Sprintf("%s%d/", jobTemplate.URI, id)
+
+	response, err := jobTemplate.connection.Patch(uri, data)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = jobTemplate.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// CopyJobTemplate copies a job template by ID
+//
+//	:param id: The ID of the job template to copy
+//	:param name: The name of the new job template
+func (jobTemplate *JobTemplate) CopyJobTemplate(id int32, name string) (schemaResponse JobTemplateResponseSingleSchema, err error) {
+	schemaResponse = JobTemplateResponseSingleSchema{}
+
+	uri := fmt.Sprintf("%s%d/copy/", jobTemplate.URI, id)
+
+	data, err := json.Marshal(JobTemplateCopyRequestSchema{Name: name})
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	response, err := jobTemplate.connection.Post(uri, data)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = jobTemplate.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// DeleteJobTemplate deletes a job template by ID
+//
+//	:param id: The ID of the job template to delete
+func (jobTemplate *JobTemplate) DeleteJobTemplate(id int32) (statusCode int, err error) {
+	uri := fmt.Sprintf("%s%d/", jobTemplate.URI, id)
+
+	response, err := jobTemplate.connection.Delete(uri, nil)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response.StatusCode, nil
+}
@@ -1,12 +1,16 @@
 package jobtemplates
 
 // JobTemplateRequestSchema is the schema for a job templates request
+//
+// Inventory, Project, ExecutionEnvironment and WebhookCredential are IDs and are not sent when 0, use
+// JobTemplate.ClearExecutionEnvironment and JobTemplate.ClearWebhookCredential to remove an execution environment or
+// webhook credential.
 type JobTemplateRequestSchema struct {
 	Name                            string `json:"name" yaml:"name"`
 	Description                     string `json:"description" yaml:"description"`
 	JobType                         string `json:"job_type" yaml:"job_type"`
-	Inventory                       int32  `json:"inventory" yaml:"inventory"`
-	Project                         int32  `json:"project" yaml:"project"`
+	Inventory                       int32  `json:"inventory,omitempty" yaml:"inventory,omitempty"`
+	Project                         int32  `json:"project,omitempty" yaml:"project,omitempty"`
 	Playbook                        string `json:"playbook" yaml:"playbook"`
 	ScmBranch                       string `json:"scm_branch" yaml:"scm_branch"`
 	Forks                           int32  `json:"forks" yaml:"forks"`
@@ -19,7 +23,7 @@ type JobTemplateRequestSchema struct {
 	StartAtTask                     string `json:"start_at_task" yaml:"start_at_task"`
 	Timeout                         int32  `json:"timeout" yaml:"timeout"`
 	UseFactCache                    bool   `json:"use_fact_cache" yaml:"use_fact_cache"`
-	ExecutionEnvironment            string `json:"execution_environment" yaml:"execution_environment"`
+	ExecutionEnvironment            int32  `json:"execution_environment,omitempty" yaml:"execution_environment,omitempty"`
 	HostConfigKey                   string `json:"host_config_key" yaml:"host_config_key"`
 	AskScmBranchOnLaunch            bool   `json:"ask_scm_branch_on_launch" yaml:"ask_scm_branch_on_launch"`
 	AskDiffModeOnLaunch             bool   `json:"ask_diff_mode_on_launch" yaml:"ask_diff_mode_on_launch"`
@@ -43,10 +47,58 @@ type JobTemplateRequestSchema struct {
 	AllowSimultaneous               bool   `json:"allow_simultaneous" yaml:"allow_simultaneous"`
 	JobSliceCount                   int32  `json:"job_slice_count" yaml:"job_slice_count"`
 	WebhookService                  string `json:"webhook_service" yaml:"webhook_service"`
-	WebhookCredential               string `json:"webhook_credential" yaml:"webhook_credential"`
+	WebhookCredential               int32  `json:"webhook_credential,omitempty" yaml:"webhook_credential,omitempty"`
 	PreventInstanceGroupFallback    bool   `json:"prevent_instance_group_fallback" yaml:"prevent_instance_group_fallback"`
 }
 
+// JobTemplateUpdateRequestSchema is the schema for a job templates update request, only the fields that are set are
+// sent so the others keep their current value
+type JobTemplateUpdateRequestSchema struct {
+	Name                            *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Description                     *string `json:"description,omitempty" yaml:"description,omitempty"`
+	JobType                         *string `json:"job_type,omitempty" yaml:"job_type,omitempty"`
+	Inventory                       *int32  `json:"inventory,omitempty" yaml:"inventory,omitempty"`
+	Project                         *int32  `json:"project,omitempty" yaml:"project,omitempty"`
+	Playbook                        *string `json:"playbook,omitempty" yaml:"playbook,omitempty"`
+	ScmBranch                       *string `json:"scm_branch,omitempty" yaml:"scm_branch,omitempty"`
+	Forks                           *int32  `json:"forks,omitempty" yaml:"forks,omitempty"`
+	Limit                           *string `json:"limit,omitempty" yaml:"limit,omitempty"`
+	Verbosity                       *int32  `json:"verbosity,omitempty" yaml:"verbosity,omitempty"`
+	ExtraVars                       *string `json:"extra_vars,omitempty" yaml:"extra_vars,omitempty"`
+	JobTags                         *string `json:"job_tags,omitempty" yaml:"job_tags,omitempty"`
+	ForceHandlers                   *bool   `json:"force_handlers,omitempty" yaml:"force_handlers,omitempty"`
+	SkipTags                        *string `json:"skip_tags,omitempty" yaml:"skip_tags,omitempty"`
+	StartAtTask                     *string `json:"start_at_task,omitempty" yaml:"start_at_task,omitempty"`
+	Timeout                         *int32  `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	UseFactCache                    *bool   `json:"use_fact_cache,omitempty" yaml:"use_fact_cache,omitempty"`
+	ExecutionEnvironment            *int32  `json:"execution_environment,omitempty" yaml:"execution_environment,omitempty"`
+	HostConfigKey                   *string `json:"host_config_key,omitempty" yaml:"host_config_key,omitempty"`
+	AskScmBranchOnLaunch            *bool   `json:"ask_scm_branch_on_launch,omitempty" yaml:"ask_scm_branch_on_launch,omitempty"`
+	AskDiffModeOnLaunch             *bool   `json:"ask_diff_mode_on_launch,omitempty" yaml:"ask_diff_mode_on_launch,omitempty"`
+	AskVariablesOnLaunch            *bool   `json:"ask_variables_on_launch,omitempty" yaml:"ask_variables_on_launch,omitempty"`
+	AskLimitOnLaunch                *bool   `json:"ask_limit_on_launch,omitempty" yaml:"ask_limit_on_launch,omitempty"`
+	AskTagsOnLaunch                 *bool   `json:"ask_tags_on_launch,omitempty" yaml:"ask_tags_on_launch,omitempty"`
+	AskSkipTagsOnLaunch             *bool   `json:"ask_skip_tags_on_launch,omitempty" yaml:"ask_skip_tags_on_launch,omitempty"`
+	AskJobTypeOnLaunch              *bool   `json:"ask_job_type_on_launch,omitempty" yaml:"ask_job_type_on_launch,omitempty"`
+	AskVerbosityOnLaunch            *bool   `json:"ask_verbosity_on_launch,omitempty" yaml:"ask_verbosity_on_launch,omitempty"`
+	AskInventoryOnLaunch            *bool   `json:"ask_inventory_on_launch,omitempty" yaml:"ask_inventory_on_launch,omitempty"`
+	AskCredentialOnLaunch           *bool   `json:"ask_credential_on_launch,omitempty" yaml:"ask_credential_on_launch,omitempty"`
+	AskExecutionEnvironmentOnLaunch *bool   `json:"ask_execution_environment_on_launch,omitempty" yaml:"ask_execution_environment_on_launch,omitempty"`
+	AskLabelsOnLaunch               *bool   `json:"ask_labels_on_launch,omitempty" yaml:"ask_labels_on_launch,omitempty"`
+	AskForksOnLaunch                *bool   `json:"ask_forks_on_launch,omitempty" yaml:"ask_forks_on_launch,omitempty"`
+	AskJobSliceCountOnLaunch        *bool   `json:"ask_job_slice_count_on_launch,omitempty" yaml:"ask_job_slice_count_on_launch,omitempty"`
+	AskTimeoutOnLaunch              *bool   `json:"ask_timeout_on_launch,omitempty" yaml:"ask_timeout_on_launch,omitempty"`
+	AskInstanceGroupsOnLaunch       *bool   `json:"ask_instance_groups_on_launch,omitempty" yaml:"ask_instance_groups_on_launch,omitempty"`
+	SurveyEnabled                   *bool   `json:"survey_enabled,omitempty" yaml:"survey_enabled,omitempty"`
+	BecomeEnabled                   *bool   `json:"become_enabled,omitempty" yaml:"become_enabled,omitempty"`
+	DiffMode                        *bool   `json:"diff_mode,omitempty" yaml:"diff_mode,omitempty"`
+	AllowSimultaneous               *bool   `json:"allow_simultaneous,omitempty" yaml:"allow_simultaneous,omitempty"`
+	JobSliceCount                   *int32  `json:"job_slice_count,omitempty" yaml:"job_slice_count,omitempty"`
+	WebhookService                  *string `json:"webhook_service,omitempty" yaml:"webhook_service,omitempty"`
+	WebhookCredential               *int32  `json:"webhook_credential,omitempty" yaml:"webhook_credential,omitempty"`
+	PreventInstanceGroupFallback    *bool   `json:"prevent_instance_group_fallback,omitempty" yaml:"prevent_instance_group_fallback,omitempty"`
+}
+
 // JobTemplateSimpleRequestSchema is the schema for a simple job templates request
 type JobTemplateSimpleRequestSchema struct {
 	Inventory int32  `json:"inventory" yaml:"inventory"`
@@ -96,3 +148,33 @@ type JobTemplateResponseSchema struct {
 	Previous string                            `json:"previous" yaml:"previous"`
 	Results  []JobTemplateResponseSingleSchema `json:"results" yaml:"results"`
 }
+
+// JobTemplateCopyRequestSchema is the schema for a job template copy request
+type JobTemplateCopyRequestSchema struct {
+	Name string `json:"name" yaml:"name"`
+}
+
+// LabelRequestSchema is the schema for creating and associating a label in one request
+type LabelRequestSchema struct {
+	Name         string `json:"name" yaml:"name"`
+	Organization int32  `json:"organization" yaml:"organization"`
+}
+
+// RelatedResponseSingleSchema is the schema for a single item of a related list response
+type RelatedResponseSingleSchema struct {
+	ID          int32  `json:"id" yaml:"id"`
+	Type        string `json:"type" yaml:"type"`
+	URL         string `json:"url" yaml:"url"`
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description" yaml:"description"`
+	Created     string `json:"created" yaml:"created"`
+	Modified    string `json:"modified" yaml:"modified"`
+}
+
+// RelatedResponseSchema is the schema for a related list response
+type RelatedResponseSchema struct {
+	Count    int32                         `json:"count" yaml:"count"`
+	Next     string                        `json:"next" yaml:"next"`
+	Previous string                        `json:"previous" yaml:"previous"`
+	Results  []RelatedResponseSingleSchema `json:"results" yaml:"results"`
+}
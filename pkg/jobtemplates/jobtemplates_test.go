@@ -0,0 +1,81 @@
+package jobtemplates
+
+import (
+	"github.com/btr1975/go-ansible-aap-api-client/internal/fakeconnection"
+	"net/http"
+	"testing"
+)
+
+// newFakeJobTemplateConnection answers the create and patch of job template 7 and records the requests
+func newFakeJobTemplateConnection() *fakeconnection.Connection {
+	return fakeconnection.New().
+		HandleJSON(http.MethodPost, "job_templates/", JobTemplateResponseSingleSchema{ID: 7}).
+		HandleJSON(http.MethodPatch, "job_templates/7/", JobTemplateResponseSingleSchema{ID: 7})
+}
+
+func TestJobTemplate_CreateJobTemplate(t *testing.T) {
+	connection := newFakeJobTemplateConnection()
+
+	_, err := NewJobTemplate(connection).CreateJobTemplate(JobTemplateRequestSchema{Name: "Deploy", Playbook: "deploy.yml"})
+
+	if err != nil {
+		t.Fatalf("JobTemplate.CreateJobTemplate() error = %v", err)
+	}
+
+	data := map[string]any{}
+	_ = connection.Requests()[0].Decode(&data)
+
+	for _, field := range []string{"inventory", "project", "execution_environment", "webhook_credential"} {
+		if _, ok := data[field]; ok {
+			t.Errorf("JobTemplate.CreateJobTemplate() sent %s = %v, want it left out", field, data[field])
+		}
+	}
+}
+
+func TestJobTemplate_UpdateJobTemplate(t *testing.T) {
+	limit := "core"
+	allowSimultaneous := false
+
+	tests := []struct {
+		name   string
+		update func(jobTemplate *JobTemplate) (JobTemplateResponseSingleSchema, error)
+		want   string
+	}{
+		{
+			name: "Test UpdateJobTemplate sends only the set fields",
+			update: func(jobTemplate *JobTemplate) (JobTemplateResponseSingleSchema, error) {
+				return jobTemplate.UpdateJobTemplate(7, JobTemplateUpdateRequestSchema{Limit: &limit, AllowSimultaneous: &allowSimultaneous})
+			},
+			want: `{"limit":"core","allow_simultaneous":false}`,
+		},
+		{
+			name: "Test ClearExecutionEnvironment",
+			update: func(jobTemplate *JobTemplate) (JobTemplateResponseSingleSchema, error) {
+				return jobTemplate.ClearExecutionEnvironment(7)
+			},
+			want: `{"execution_environment": null}`,
+		},
+		{
+			name: "Test ClearWebhookCredential",
+			update: func(jobTemplate *JobTemplate) (JobTemplateResponseSingleSchema, error) {
+				return jobTemplate.ClearWebhookCredential(7)
+			},
+			want: `{"webhook_credential": null}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			connection := newFakeJobTemplateConnection()
+
+			response, err := tt.update(NewJobTemplate(connection))
+
+			if err != nil || response.ID != 7 {
+				t.Fatalf("JobTemplate update = %+v, err = %v", response, err)
+			}
+
+			if got := string(connection.Requests()[0].Data); got != tt.want {
+				t.Errorf("JobTemplate update sent %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package jobtemplates
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// MarshalJSON encodes a launch request with the passwords as top level fields
+func (launchData JobTemplateLaunchRequestSchema) MarshalJSON() ([]byte, error) {
+	type launchRequest JobTemplateLaunchRequestSchema
+
+	data, err := json.Marshal(launchRequest(launchData))
+
+	if err != nil || len(launchData.Passwords) == 0 {
+		return data, err
+	}
+
+	merged := map[string]any{}
+
+	err = json.Unmarshal(data, &merged)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for name, password := range launchData.Passwords {
+		merged[name] = password
+	}
+
+	return json.Marshal(merged)
+}
+
+// ToLaunchRequest converts a simple launch request to a full launch request
+func (launchData JobTemplateSimpleRequestSchema) ToLaunchRequest() (launchRequest JobTemplateLaunchRequestSchema, err error) {
+	extraVars, err := ParseExtraVars(launchData.ExtraVars)
+
+	if err != nil {
+		return JobTemplateLaunchRequestSchema{}, err
+	}
+
+	launchRequest = JobTemplateLaunchRequestSchema{
+		Inventory: launchData.Inventory,
+	}
+
+	if len(extraVars) > 0 {
+		launchRequest.ExtraVars = extraVars
+	}
+
+	return launchRequest, nil
+}
+
+// EnabledPrompts gets the names of the prompts that are asked on launch
+func (launchInfo LaunchInfoSchema) EnabledPrompts() (prompts []string) {
+	prompts = []string{}
+
+	askOnLaunch := []struct {
+		name    string
+		enabled bool
+	}{
+		{"scm_branch", launchInfo.AskScmBranchOnLaunch},
+		{"diff_mode", launchInfo.AskDiffModeOnLaunch},
+		{"extra_vars", launchInfo.AskVariablesOnLaunch},
+		{"limit", launchInfo.AskLimitOnLaunch},
+		{"job_tags", launchInfo.AskTagsOnLaunch},
+		{"skip_tags", launchInfo.AskSkipTagsOnLaunch},
+		{"job_type", launchInfo.AskJobTypeOnLaunch},
+		{"verbosity", launchInfo.AskVerbosityOnLaunch},
+		{"inventory", launchInfo.AskInventoryOnLaunch},
+		{"credentials", launchInfo.AskCredentialOnLaunch},
+		{"execution_environment", launchInfo.AskExecutionEnvironmentOnLaunch},
+		{"labels", launchInfo.AskLabelsOnLaunch},
+		{"forks", launchInfo.AskForksOnLaunch},
+		{"job_slice_count", launchInfo.AskJobSliceCountOnLaunch},
+		{"timeout", launchInfo.AskTimeoutOnLaunch},
+		{"instance_groups", launchInfo.AskInstanceGroupsOnLaunch},
+	}
+
+	for _, prompt := range askOnLaunch {
+		if prompt.enabled {
+			prompts = append(prompts, prompt.name)
+		}
+	}
+
+	return prompts
+}
+
+// ValidateLaunchRequest validates a proposed launch request against the launch metadata
+//
+//	:param launchData: The launch data to validate
+func (launchInfo LaunchInfoSchema) ValidateLaunchRequest(launchData JobTemplateLaunchRequestSchema) (err error) {
+	var errs []error
+
+	if launchData.Inventory != 0 && launchData.Inventory != launchInfo.Defaults.Inventory.ID && !launchInfo.AskInventoryOnLaunch {
+		errs = append(errs, fmt.Errorf("inventory %d was given but the job template does not prompt for inventory", launchData.Inventory))
+	}
+
+	if launchInfo.InventoryNeededToStart && launchData.Inventory == 0 {
+		errs = append(errs, errors.New("an inventory is needed to start the job template"))
+	}
+
+	if launchInfo.CredentialNeededToStart && len(launchData.Credentials) == 0 {
+		errs = append(errs, errors.New("a credential is needed to start the job template"))
+	}
+
+	for _, password := range launchInfo.PasswordsNeededToStart {
+		if launchData.Passwords[password] == "" {
+			errs = append(errs, fmt.Errorf("password %s is needed to start the job template", password))
+		}
+	}
+
+	if len(launchData.ExtraVars) > 0 && !launchInfo.AskVariablesOnLaunch && !launchInfo.SurveyEnabled {
+		errs = append(errs, errors.New("extra vars were given but the job template does not prompt for variables"))
+	}
+
+	for _, variable := range launchInfo.VariablesNeededToStart {
+		if value, ok := launchData.ExtraVars[variable]; !ok || value == nil || value == "" {
+			errs = append(errs, fmt.Errorf("variable %s is needed to start the job template", variable))
+		}
+	}
+
+	notPrompted := []struct {
+		name    string
+		given   bool
+		enabled bool
+	}{
+		{"limit", launchData.Limit != "", launchInfo.AskLimitOnLaunch},
+		{"job_tags", launchData.JobTags != "", launchInfo.AskTagsOnLaunch},
+		{"skip_tags", launchData.SkipTags != "", launchInfo.AskSkipTagsOnLaunch},
+		{"job_type", launchData.JobType != "", launchInfo.AskJobTypeOnLaunch},
+		{"verbosity", launchData.Verbosity != nil, launchInfo.AskVerbosityOnLaunch},
+		{"diff_mode", launchData.DiffMode != nil, launchInfo.AskDiffModeOnLaunch},
+		{"credentials", len(launchData.Credentials) > 0, launchInfo.AskCredentialOnLaunch},
+		{"execution_environment", launchData.ExecutionEnvironment != 0, launchInfo.AskExecutionEnvironmentOnLaunch},
+		{"labels", len(launchData.Labels) > 0, launchInfo.AskLabelsOnLaunch},
+		{"forks", launchData.Forks != nil, launchInfo.AskForksOnLaunch},
+		{"job_slice_count", launchData.JobSliceCount != nil, launchInfo.AskJobSliceCountOnLaunch},
+		{"timeout", launchData.Timeout != nil, launchInfo.AskTimeoutOnLaunch},
+		{"instance_groups", len(launchData.InstanceGroups) > 0, launchInfo.AskInstanceGroupsOnLaunch},
+		{"scm_branch", launchData.ScmBranch != "", launchInfo.AskScmBranchOnLaunch},
+	}
+
+	for _, prompt := range notPrompted {
+		if prompt.given && !prompt.enabled {
+			errs = append(errs, fmt.Errorf("%s was given but the job template does not prompt for it", prompt.name))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidateSimpleLaunchRequest validates a proposed simple launch request against the launch metadata
+//
+//	:param launchData: The launch data to validate
+func (launchInfo LaunchInfoSchema) ValidateSimpleLaunchRequest(launchData JobTemplateSimpleRequestSchema) (err error) {
+	launchRequest, err := launchData.ToLaunchRequest()
+
+	if err != nil {
+		return err
+	}
+
+	return launchInfo.ValidateLaunchRequest(launchRequest)
+}
+
+// GetLaunchInfo gets the launch metadata of a job template by ID
+//
+//	:param id: The ID of the job template
+func (jobTemplate *JobTemplate) GetLaunchInfo(id int32) (schemaResponse LaunchInfoSchema, err error) {
+	schemaResponse = LaunchInfoSchema{}
+
+	uri := fmt.Sprintf("%s%d/launch/", jobTemplate.URI, id)
+
+	response, err := jobTemplate.connection.Get(uri, nil)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = jobTemplate.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// PreflightLaunch gets the launch metadata of a job template by ID and validates a proposed launch request against it
+//
+//	:param id: The ID of the job template
+//	:param launchData: The launch data to validate
+func (jobTemplate *JobTemplate) PreflightLaunch(id int32, launchData JobTemplateLaunchRequestSchema) (schemaResponse LaunchInfoSchema, err error) {
+	schemaResponse, err = jobTemplate.GetLaunchInfo(id)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, schemaResponse.ValidateLaunchRequest(launchData)
+}
@@ -0,0 +1,94 @@
+package jobtemplates
+
+// LaunchObjectSchema is the schema for an object referenced in the launch metadata
+type LaunchObjectSchema struct {
+	ID   int32  `json:"id" yaml:"id"`
+	Name string `json:"name" yaml:"name"`
+}
+
+// LaunchCredentialSchema is the schema for a default credential in the launch metadata
+type LaunchCredentialSchema struct {
+	ID              int32    `json:"id" yaml:"id"`
+	Name            string   `json:"name" yaml:"name"`
+	CredentialType  int32    `json:"credential_type" yaml:"credential_type"`
+	PasswordsNeeded []string `json:"passwords_needed" yaml:"passwords_needed"`
+}
+
+// LaunchDefaultsSchema is the schema for the defaults section of the launch metadata
+type LaunchDefaultsSchema struct {
+	ExtraVars            string                   `json:"extra_vars" yaml:"extra_vars"`
+	DiffMode             bool                     `json:"diff_mode" yaml:"diff_mode"`
+	Limit                string                   `json:"limit" yaml:"limit"`
+	JobTags              string                   `json:"job_tags" yaml:"job_tags"`
+	SkipTags             string                   `json:"skip_tags" yaml:"skip_tags"`
+	JobType              string                   `json:"job_type" yaml:"job_type"`
+	Verbosity            int32                    `json:"verbosity" yaml:"verbosity"`
+	Inventory            LaunchObjectSchema       `json:"inventory" yaml:"inventory"`
+	Credentials          []LaunchCredentialSchema `json:"credentials" yaml:"credentials"`
+	ScmBranch            string                   `json:"scm_branch" yaml:"scm_branch"`
+	ExecutionEnvironment LaunchObjectSchema       `json:"execution_environment" yaml:"execution_environment"`
+	Labels               []LaunchObjectSchema     `json:"labels" yaml:"labels"`
+	Forks                int32                    `json:"forks" yaml:"forks"`
+	Timeout              int32                    `json:"timeout" yaml:"timeout"`
+	JobSliceCount        int32                    `json:"job_slice_count" yaml:"job_slice_count"`
+	InstanceGroups       []LaunchObjectSchema     `json:"instance_groups" yaml:"instance_groups"`
+}
+
+// LaunchJobTemplateDataSchema is the schema for the job template section of the launch metadata
+type LaunchJobTemplateDataSchema struct {
+	ID          int32  `json:"id" yaml:"id"`
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description" yaml:"description"`
+}
+
+// LaunchInfoSchema is the schema for the launch metadata returned by a GET of the launch endpoint
+type LaunchInfoSchema struct {
+	CanStartWithoutUserInput        bool                        `json:"can_start_without_user_input" yaml:"can_start_without_user_input"`
+	PasswordsNeededToStart          []string                    `json:"passwords_needed_to_start" yaml:"passwords_needed_to_start"`
+	VariablesNeededToStart          []string                    `json:"variables_needed_to_start" yaml:"variables_needed_to_start"`
+	CredentialNeededToStart         bool                        `json:"credential_needed_to_start" yaml:"credential_needed_to_start"`
+	InventoryNeededToStart          bool                        `json:"inventory_needed_to_start" yaml:"inventory_needed_to_start"`
+	SurveyEnabled                   bool                        `json:"survey_enabled" yaml:"survey_enabled"`
+	AskScmBranchOnLaunch            bool                        `json:"ask_scm_branch_on_launch" yaml:"ask_scm_branch_on_launch"`
+	AskDiffModeOnLaunch             bool                        `json:"ask_diff_mode_on_launch" yaml:"ask_diff_mode_on_launch"`
+	AskVariablesOnLaunch            bool                        `json:"ask_variables_on_launch" yaml:"ask_variables_on_launch"`
+	AskLimitOnLaunch                bool                        `json:"ask_limit_on_launch" yaml:"ask_limit_on_launch"`
+	AskTagsOnLaunch                 bool                        `json:"ask_tags_on_launch" yaml:"ask_tags_on_launch"`
+	AskSkipTagsOnLaunch             bool                        `json:"ask_skip_tags_on_launch" yaml:"ask_skip_tags_on_launch"`
+	AskJobTypeOnLaunch              bool                        `json:"ask_job_type_on_launch" yaml:"ask_job_type_on_launch"`
+	AskVerbosityOnLaunch            bool                        `json:"ask_verbosity_on_launch" yaml:"ask_verbosity_on_launch"`
+	AskInventoryOnLaunch            bool                        `json:"ask_inventory_on_launch" yaml:"ask_inventory_on_launch"`
+	AskCredentialOnLaunch           bool                        `json:"ask_credential_on_launch" yaml:"ask_credential_on_launch"`
+	AskExecutionEnvironmentOnLaunch bool                        `json:"ask_execution_environment_on_launch" yaml:"ask_execution_environment_on_launch"`
+	AskLabelsOnLaunch               bool                        `json:"ask_labels_on_launch" yaml:"ask_labels_on_launch"`
+	AskForksOnLaunch                bool                        `json:"ask_forks_on_launch" yaml:"ask_forks_on_launch"`
+	AskJobSliceCountOnLaunch        bool                        `json:"ask_job_slice_count_on_launch" yaml:"ask_job_slice_count_on_launch"`
+	AskTimeoutOnLaunch              bool                        `json:"ask_timeout_on_launch" yaml:"ask_timeout_on_launch"`
+	AskInstanceGroupsOnLaunch       bool                        `json:"ask_instance_groups_on_launch" yaml:"ask_instance_groups_on_launch"`
+	JobTemplateData                 LaunchJobTemplateDataSchema `json:"job_template_data" yaml:"job_template_data"`
+	Defaults                        LaunchDefaultsSchema        `json:"defaults" yaml:"defaults"`
+}
+
+// JobTemplateLaunchRequestSchema is the schema for a job template launch request covering every launch prompt
+//
+// Only the fields that are set are sent, so a prompt that is not asked on launch can be left empty.
+// Passwords are sent as top level fields named after the passwords needed to start, for example ssh_password.
+type JobTemplateLaunchRequestSchema struct {
+	Inventory            int32             `json:"inventory,omitempty" yaml:"inventory,omitempty"`
+	ExtraVars            map[string]any    `json:"extra_vars,omitempty" yaml:"extra_vars,omitempty"`
+	Limit                string            `json:"limit,omitempty" yaml:"limit,omitempty"`
+	JobTags              string            `json:"job_tags,omitempty" yaml:"job_tags,omitempty"`
+	SkipTags             string            `json:"skip_tags,omitempty" yaml:"skip_tags,omitempty"`
+	JobType              string            `json:"job_type,omitempty" yaml:"job_type,omitempty"`
+	Verbosity            *int32            `json:"verbosity,omitempty" yaml:"verbosity,omitempty"`
+	DiffMode             *bool             `json:"diff_mode,omitempty" yaml:"diff_mode,omitempty"`
+	Credentials          []int32           `json:"credentials,omitempty" yaml:"credentials,omitempty"`
+	ExecutionEnvironment int32             `json:"execution_environment,omitempty" yaml:"execution_environment,omitempty"`
+	Labels               []int32           `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Forks                *int32            `json:"forks,omitempty" yaml:"forks,omitempty"`
+	JobSliceCount        *int32            `json:"job_slice_count,omitempty" yaml:"job_slice_count,omitempty"`
+	Timeout              *int32            `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	InstanceGroups       []int32           `json:"instance_groups,omitempty" yaml:"instance_groups,omitempty"`
+	ScmBranch            string            `json:"scm_branch,omitempty" yaml:"scm_branch,omitempty"`
+	Passwords            map[string]string `json:"-" yaml:"-"`
+}
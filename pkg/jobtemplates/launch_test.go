@@ -0,0 +1,178 @@
+package jobtemplates
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const testLaunchInfo = `{
+	"can_start_without_user_input": false,
+	"passwords_needed_to_start": [],
+	"ask_variables_on_launch": false,
+	"ask_limit_on_launch": true,
+	"ask_inventory_on_launch": true,
+	"survey_enabled": false,
+	"variables_needed_to_start": ["change_id"],
+	"credential_needed_to_start": false,
+	"inventory_needed_to_start": true,
+	"job_template_data": {"name": "Deploy", "id": 7, "description": ""},
+	"defaults": {
+		"extra_vars": "",
+		"job_type": "run",
+		"inventory": {"name": null, "id": null},
+		"credentials": [{"id": 3, "name": "switches", "credential_type": 1, "passwords_needed": []}]
+	}
+}`
+
+func TestLaunchInfoSchema_ValidateSimpleLaunchRequest(t *testing.T) {
+	launchInfo := LaunchInfoSchema{}
+
+	if err := json.Unmarshal([]byte(testLaunchInfo), &launchInfo); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	if got := launchInfo.EnabledPrompts(); len(got) != 2 || got[0] != "limit" || got[1] != "inventory" {
+		t.Errorf("LaunchInfoSchema.EnabledPrompts() = %v", got)
+	}
+
+	tests := []struct {
+		name       string
+		launchInfo func(LaunchInfoSchema) LaunchInfoSchema
+		launchData JobTemplateSimpleRequestSchema
+		wantErr    bool
+	}{
+		{
+			name:       "Test ValidateSimpleLaunchRequest missing inventory",
+			launchData: JobTemplateSimpleRequestSchema{ExtraVars: `{"change_id": "CHG1"}`},
+			launchInfo: func(info LaunchInfoSchema) LaunchInfoSchema {
+				info.AskVariablesOnLaunch = true
+				return info
+			},
+			wantErr: true,
+		},
+		{
+			name:       "Test ValidateSimpleLaunchRequest extra vars not prompted",
+			launchData: JobTemplateSimpleRequestSchema{Inventory: 2, ExtraVars: `{"change_id": "CHG1"}`},
+			wantErr:    true,
+		},
+		{
+			name:       "Test ValidateSimpleLaunchRequest missing variable",
+			launchData: JobTemplateSimpleRequestSchema{Inventory: 2, ExtraVars: `{"other": 1}`},
+			launchInfo: func(info LaunchInfoSchema) LaunchInfoSchema {
+				info.AskVariablesOnLaunch = true
+				return info
+			},
+			wantErr: true,
+		},
+		{
+			name:       "Test ValidateSimpleLaunchRequest password needed",
+			launchData: JobTemplateSimpleRequestSchema{Inventory: 2, ExtraVars: `{"change_id": "CHG1"}`},
+			launchInfo: func(info LaunchInfoSchema) LaunchInfoSchema {
+				info.AskVariablesOnLaunch = true
+				info.PasswordsNeededToStart = []string{"ssh_password"}
+				return info
+			},
+			wantErr: true,
+		},
+		{
+			name:       "Test ValidateSimpleLaunchRequest valid",
+			launchData: JobTemplateSimpleRequestSchema{Inventory: 2, ExtraVars: `{"change_id": "CHG1"}`},
+			launchInfo: func(info LaunchInfoSchema) LaunchInfoSchema {
+				info.AskVariablesOnLaunch = true
+				return info
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := launchInfo
+
+			if tt.launchInfo != nil {
+				info = tt.launchInfo(info)
+			}
+
+			if err := info.ValidateSimpleLaunchRequest(tt.launchData); (err != nil) != tt.wantErr {
+				t.Errorf("LaunchInfoSchema.ValidateSimpleLaunchRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLaunchInfoSchema_ValidateLaunchRequest(t *testing.T) {
+	verbosity := int32(3)
+
+	tests := []struct {
+		name       string
+		launchInfo LaunchInfoSchema
+		launchData JobTemplateLaunchRequestSchema
+		wantErr    bool
+	}{
+		{
+			name:       "Test ValidateLaunchRequest prompt not enabled",
+			launchInfo: LaunchInfoSchema{AskLimitOnLaunch: true},
+			launchData: JobTemplateLaunchRequestSchema{Limit: "edge", Verbosity: &verbosity},
+			wantErr:    true,
+		},
+		{
+			name:       "Test ValidateLaunchRequest password given",
+			launchInfo: LaunchInfoSchema{AskCredentialOnLaunch: true, CredentialNeededToStart: true, PasswordsNeededToStart: []string{"ssh_password"}},
+			launchData: JobTemplateLaunchRequestSchema{Credentials: []int32{4}, Passwords: map[string]string{"ssh_password": "secret"}},
+			wantErr:    false,
+		},
+		{
+			name:       "Test ValidateLaunchRequest credential missing",
+			launchInfo: LaunchInfoSchema{AskCredentialOnLaunch: true, CredentialNeededToStart: true},
+			launchData: JobTemplateLaunchRequestSchema{},
+			wantErr:    true,
+		},
+		{
+			name:       "Test ValidateLaunchRequest every prompt",
+			launchInfo: LaunchInfoSchema{AskLimitOnLaunch: true, AskVerbosityOnLaunch: true, AskLabelsOnLaunch: true, AskScmBranchOnLaunch: true},
+			launchData: JobTemplateLaunchRequestSchema{Limit: "edge", Verbosity: &verbosity, Labels: []int32{1}, ScmBranch: "main"},
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.launchInfo.ValidateLaunchRequest(tt.launchData); (err != nil) != tt.wantErr {
+				t.Errorf("LaunchInfoSchema.ValidateLaunchRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJobTemplateLaunchRequestSchema_MarshalJSON(t *testing.T) {
+	verbosity := int32(0)
+
+	launchData := JobTemplateLaunchRequestSchema{
+		Inventory: 2,
+		ExtraVars: map[string]any{"change_id": "CHG1"},
+		Verbosity: &verbosity,
+		Passwords: map[string]string{"ssh_password": "secret"},
+	}
+
+	data, err := json.Marshal(launchData)
+
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	got := map[string]any{}
+
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	if got["ssh_password"] != "secret" || got["verbosity"] != float64(0) || got["inventory"] != float64(2) {
+		t.Errorf("JobTemplateLaunchRequestSchema.MarshalJSON() = %s", data)
+	}
+
+	if _, ok := got["limit"]; ok {
+		t.Errorf("JobTemplateLaunchRequestSchema.MarshalJSON() = %s, sent an empty limit", data)
+	}
+
+	if _, ok := got["Passwords"]; ok {
+		t.Errorf("JobTemplateLaunchRequestSchema.MarshalJSON() = %s, sent the passwords map", data)
+	}
+}
@@ -0,0 +1,246 @@
+package jobtemplates
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/connection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/jobs"
+	"slices"
+	"sync"
+)
+
+// LaunchSpec is a single launch run by the multi launcher
+type LaunchSpec struct {
+	// Name identifies the launch in the report, for example the inventory or limit it runs against
+	Name          string
+	JobTemplateID int32
+	LaunchData    JobTemplateLaunchRequestSchema
+}
+
+// LaunchOutcome is the outcome of a single launch run by the multi launcher
+type LaunchOutcome struct {
+	Name          string
+	JobTemplateID int32
+	JobID         int32
+	Status        string
+	Result        *JobResult
+	Err           error
+}
+
+// LaunchReport is the aggregated report of the launches run by the multi launcher
+type LaunchReport struct {
+	// Outcomes are in the same order as the launch specs
+	Outcomes   []LaunchOutcome
+	Successful int
+	Failed     int
+}
+
+// Failures gets the outcomes of the launches that did not finish successfully
+func (report LaunchReport) Failures() (failures []LaunchOutcome) {
+	failures = []LaunchOutcome{}
+
+	for _, outcome := range report.Outcomes {
+		if outcome.Err != nil {
+			failures = append(failures, outcome)
+		}
+	}
+
+	return failures
+}
+
+// Err gets the errors of every failed launch joined together, nil when every launch was successful
+func (report LaunchReport) Err() error {
+	var errs []error
+
+	for _, outcome := range report.Failures() {
+		errs = append(errs, fmt.Errorf("%s: %w", outcome.Name, outcome.Err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// MultiLauncher runs many launches concurrently and waits for all of them
+type MultiLauncher struct {
+	// MaxConcurrent is the maximum number of jobs running at a time, defaults to 5
+	MaxConcurrent int
+	// PollOptions are the options used to poll each job for completion
+	PollOptions PollOptions
+	jobTemplate *JobTemplate
+	job         *jobs.Job
+}
+
+// NewMultiLauncher creates a new multi launcher instance
+//
+//	:param basicConnection: The basic connection to use
+//	:param maxConcurrent: The maximum number of jobs running at a time
+func NewMultiLauncher(basicConnection connection.BasicConnection, maxConcurrent int) *MultiLauncher {
+	return &MultiLauncher{
+		MaxConcurrent: maxConcurrent,
+		jobTemplate:   NewJobTemplate(basicConnection),
+		job:           jobs.NewJob(basicConnection),
+	}
+}
+
+// LaunchSpecsForInventories creates a launch spec of a job template for each inventory, ordered by inventory name
+//
+//	:param jobTemplateID: The ID of the job template
+//	:param inventories: The inventory IDs by name
+//	:param launchData: The launch data shared by every launch
+func LaunchSpecsForInventories(jobTemplateID int32, inventories map[string]int32, launchData JobTemplateLaunchRequestSchema) (specs []LaunchSpec) {
+	specs = []LaunchSpec{}
+
+	names := []string{}
+
+	for name := range inventories {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	for _, name := range names {
+		spec := LaunchSpec{Name: name, JobTemplateID: jobTemplateID, LaunchData: launchData}
+		spec.LaunchData.Inventory = inventories[name]
+		specs = append(specs, spec)
+	}
+
+	return specs
+}
+
+// LaunchSpecsForLimits creates a launch spec of a job template for each limit
+//
+//	:param jobTemplateID: The ID of the job template
+//	:param limits: The limits to launch against
+//	:param launchData: The launch data shared by every launch
+func LaunchSpecsForLimits(jobTemplateID int32, limits []string, launchData JobTemplateLaunchRequestSchema) (specs []LaunchSpec) {
+	specs = []LaunchSpec{}
+
+	for _, limit := range limits {
+		spec := LaunchSpec{Name: limit, JobTemplateID: jobTemplateID, LaunchData: launchData}
+		spec.LaunchData.Limit = limit
+		specs = append(specs, spec)
+	}
+
+	return specs
+}
+
+// LaunchAll runs every launch with at most MaxConcurrent jobs running at a time and waits for all of them
+//
+// Launches of a job template that does not allow simultaneous jobs are run one after the other, since the
+// controller would otherwise queue them behind each other while they hold a concurrency slot.
+//
+//	:param ctx: The context used to stop launching and waiting
+//	:param specs: The launches to run
+func (launcher *MultiLauncher) LaunchAll(ctx context.Context, specs []LaunchSpec) (report LaunchReport) {
+	report = LaunchReport{Outcomes: make([]LaunchOutcome, len(specs))}
+
+	maxConcurrent := launcher.MaxConcurrent
+
+	if maxConcurrent <= 0 {
+		maxConcurrent = 5
+	}
+
+	semaphore := make(chan struct{}, maxConcurrent)
+	templateLocks := map[int32]*sync.Mutex{}
+	templateErrors := map[int32]error{}
+
+	for _, spec := range specs {
+		if _, ok := templateLocks[spec.JobTemplateID]; ok {
+			continue
+		}
+
+		templateLocks[spec.JobTemplateID] = nil
+
+		jobTemplateData, err := launcher.jobTemplate.GetJobTemplateByID(spec.JobTemplateID)
+
+		if err != nil {
+			templateErrors[spec.JobTemplateID] = err
+			continue
+		}
+
+		if !jobTemplateData.AllowSimultaneous {
+			templateLocks[spec.JobTemplateID] = &sync.Mutex{}
+		}
+	}
+
+	var wg sync.WaitGroup
+
+	for i, spec := range specs {
+		report.Outcomes[i] = LaunchOutcome{Name: spec.Name, JobTemplateID: spec.JobTemplateID}
+
+		if err := templateErrors[spec.JobTemplateID]; err != nil {
+			report.Outcomes[i].Err = err
+			continue
+		}
+
+		wg.Add(1)
+
+		go func(outcome *LaunchOutcome, spec LaunchSpec, lock *sync.Mutex) {
+			defer wg.Done()
+
+			if lock != nil {
+				lock.Lock()
+				defer lock.Unlock()
+			}
+
+			select {
+			case semaphore <- struct{}{}:
+				defer func() { <-semaphore }()
+			case <-ctx.Done():
+				outcome.Err = ctx.Err()
+				return
+			}
+
+			launcher.launch(ctx, outcome, spec)
+		}(&report.Outcomes[i], spec, templateLocks[spec.JobTemplateID])
+	}
+
+	wg.Wait()
+
+	for _, outcome := range report.Outcomes {
+		if outcome.Err != nil {
+			report.Failed++
+		} else {
+			report.Successful++
+		}
+	}
+
+	return report
+}
+
+// launch runs a single launch and waits for its result
+//
+//	:param ctx: The context used to stop waiting
+//	:param outcome: The outcome to fill in
+//	:param spec: The launch to run
+func (launcher *MultiLauncher) launch(ctx context.Context, outcome *LaunchOutcome, spec LaunchSpec) {
+	if ctx.Err() != nil {
+		outcome.Err = ctx.Err()
+		return
+	}
+
+	jobManagement := &JobManagement{
+		jobTemplate:   launcher.jobTemplate,
+		job:           launcher.job,
+		jobTemplateID: spec.JobTemplateID,
+		pollOptions:   launcher.PollOptions,
+	}
+
+	err := jobManagement.RunLaunchRequest(spec.LaunchData)
+
+	if err != nil {
+		outcome.Err = err
+		return
+	}
+
+	outcome.JobID = jobManagement.JobID()
+
+	result, err := jobManagement.WaitForResult(ctx)
+
+	outcome.Result = result
+	outcome.Err = err
+
+	if result != nil {
+		outcome.Status = result.Status
+	}
+}
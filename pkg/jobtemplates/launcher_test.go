@@ -0,0 +1,153 @@
+package jobtemplates
+
+import (
+	"context"
+	"github.com/btr1975/go-ansible-aap-api-client/internal/fakeconnection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/jobs"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeLauncher launches jobs that run for two polls and tracks how many run at a time
+type fakeLauncher struct {
+	mutex          sync.Mutex
+	nextJobID      int32
+	jobs           map[int32]*fakeLaunchedJob
+	running        map[int32]int
+	maxRunning     int
+	maxPerTemplate map[int32]int
+}
+
+type fakeLaunchedJob struct {
+	jobTemplateID int32
+	limit         string
+	polls         int
+}
+
+// connection creates a fake connection that answers with the launcher
+func (launcher *fakeLauncher) connection() *fakeconnection.Connection {
+	return fakeconnection.New().
+		HandlePrefix(http.MethodGet, "job_templates/", launcher.getJobTemplate).
+		HandlePrefix(http.MethodPost, "job_templates/", launcher.launch).
+		HandlePrefix(http.MethodGet, "jobs/", launcher.getJob)
+}
+
+func (launcher *fakeLauncher) totalRunning() (total int) {
+	for _, count := range launcher.running {
+		total += count
+	}
+
+	return total
+}
+
+func (launcher *fakeLauncher) getJobTemplate(request fakeconnection.Request) (*http.Response, error) {
+	return fakeconnection.JSON(JobTemplateResponseSingleSchema{ID: request.ID(), JobTemplateRequestSchema: JobTemplateRequestSchema{AllowSimultaneous: request.ID() == 1}})
+}
+
+func (launcher *fakeLauncher) getJob(request fakeconnection.Request) (*http.Response, error) {
+	if strings.HasSuffix(request.URI, "/job_host_summaries/") {
+		return fakeconnection.JSON(jobs.JobHostSummaryResponseSchema{})
+	}
+
+	launcher.mutex.Lock()
+	defer launcher.mutex.Unlock()
+
+	job := launcher.jobs[request.ID()]
+	job.polls++
+
+	status := "running"
+
+	if job.polls > 2 {
+		status = "successful"
+
+		if job.limit == "sw3" {
+			status = "failed"
+		}
+	}
+
+	if job.polls == 3 {
+		launcher.running[job.jobTemplateID]--
+	}
+
+	return fakeconnection.JSON(jobs.JobResponseSingleSchema{ID: request.ID(), Status: status})
+}
+
+func (launcher *fakeLauncher) launch(request fakeconnection.Request) (*http.Response, error) {
+	launcher.mutex.Lock()
+	defer launcher.mutex.Unlock()
+
+	jobTemplateID := request.ID()
+
+	launchData := JobTemplateLaunchRequestSchema{}
+	_ = request.Decode(&launchData)
+
+	launcher.nextJobID++
+	launcher.jobs[launcher.nextJobID] = &fakeLaunchedJob{jobTemplateID: jobTemplateID, limit: launchData.Limit}
+	launcher.running[jobTemplateID]++
+	launcher.maxRunning = max(launcher.maxRunning, launcher.totalRunning())
+	launcher.maxPerTemplate[jobTemplateID] = max(launcher.maxPerTemplate[jobTemplateID], launcher.running[jobTemplateID])
+
+	return fakeconnection.JSON(JobTemplateResponseSingleSchema{ID: launcher.nextJobID})
+}
+
+func TestMultiLauncher_LaunchAll(t *testing.T) {
+	fake := &fakeLauncher{jobs: map[int32]*fakeLaunchedJob{}, running: map[int32]int{}, maxPerTemplate: map[int32]int{}}
+
+	launcher := NewMultiLauncher(fake.connection(), 3)
+	launcher.PollOptions = PollOptions{Interval: time.Millisecond}
+
+	specs := LaunchSpecsForLimits(1, []string{"sw1", "sw2", "sw3", "sw4", "sw5", "sw6"}, JobTemplateLaunchRequestSchema{})
+	specs = append(specs, LaunchSpecsForLimits(2, []string{"rtr1", "rtr2", "rtr3"}, JobTemplateLaunchRequestSchema{})...)
+
+	report := launcher.LaunchAll(context.Background(), specs)
+
+	if report.Successful != 8 || report.Failed != 1 {
+		t.Errorf("MultiLauncher.LaunchAll() successful = %d, failed = %d, want 8 and 1", report.Successful, report.Failed)
+	}
+
+	failures := report.Failures()
+
+	if len(failures) != 1 || failures[0].Name != "sw3" || failures[0].Status != "failed" || !IsJobFailed(report.Err()) {
+		t.Errorf("MultiLauncher.LaunchAll() failures = %+v", failures)
+	}
+
+	if fake.maxRunning > 3 {
+		t.Errorf("MultiLauncher.LaunchAll() ran %d jobs at a time, want at most 3", fake.maxRunning)
+	}
+
+	if fake.maxPerTemplate[2] != 1 {
+		t.Errorf("MultiLauncher.LaunchAll() ran %d jobs of a template without allow_simultaneous at a time, want 1", fake.maxPerTemplate[2])
+	}
+
+	for i, outcome := range report.Outcomes {
+		if outcome.Name != specs[i].Name || outcome.JobID == 0 {
+			t.Errorf("MultiLauncher.LaunchAll() outcome %d = %+v", i, outcome)
+		}
+	}
+}
+
+func TestLaunchSpecsForInventories(t *testing.T) {
+	inventories := map[string]int32{"spine": 3, "edge": 1, "leaf": 2, "core": 4}
+
+	for range 5 {
+		specs := LaunchSpecsForInventories(1, inventories, JobTemplateLaunchRequestSchema{Limit: "routers"})
+
+		got := []string{}
+
+		for _, spec := range specs {
+			if spec.LaunchData.Inventory != inventories[spec.Name] || spec.LaunchData.Limit != "routers" {
+				t.Errorf("LaunchSpecsForInventories() spec %+v", spec)
+			}
+
+			got = append(got, spec.Name)
+		}
+
+		if want := []string{"core", "edge", "leaf", "spine"}; !slices.Equal(got, want) {
+			t.Fatalf("LaunchSpecsForInventories() names = %v, want %v", got, want)
+		}
+	}
+}
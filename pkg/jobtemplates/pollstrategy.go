@@ -0,0 +1,71 @@
+package jobtemplates
+
+import (
+	"context"
+	"errors"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/polling"
+)
+
+// ErrPollTimeout is returned when a job is not finished within the maximum wait
+var ErrPollTimeout = polling.ErrTimeout
+
+// PollOptions are the options used to poll a job for completion, the interval defaults to 5 seconds
+type PollOptions = polling.Options
+
+// StatusTransition is a change of the status of a job
+type StatusTransition = polling.StatusTransition
+
+// StatusObserver receives the status transitions of a polled job
+type StatusObserver = polling.StatusObserver
+
+// StatusObserverFunc is a function that can be used as a StatusObserver
+type StatusObserverFunc = polling.StatusObserverFunc
+
+// Logger is the logger used to report polling progress, a *log.Logger satisfies it
+type Logger = polling.Logger
+
+// SetPollOptions sets the options used to poll the launched job
+//
+//	:param options: The poll options
+func (jobManagement *JobManagement) SetPollOptions(options PollOptions) {
+	jobManagement.pollOptions = options
+}
+
+// AddStatusObserver adds an observer of the status transitions of the launched job
+//
+//	:param observer: The observer to add
+func (jobManagement *JobManagement) AddStatusObserver(observer StatusObserver) {
+	jobManagement.observers = append(jobManagement.observers, observer)
+}
+
+// SetLogger sets the logger used to report polling progress instead of printing to stdout
+//
+//	:param logger: The logger to use, nil stops logging
+func (jobManagement *JobManagement) SetLogger(logger Logger) {
+	jobManagement.logger = logger
+}
+
+// Wait polls the launched job until it is finished, the maximum wait is reached or the context is done
+//
+//	:param ctx: The context used to stop waiting
+func (jobManagement *JobManagement) Wait(ctx context.Context) (jobStatus string, err error) {
+	return jobManagement.waitForJob(ctx, jobManagement.logger)
+}
+
+// waitForJob polls the launched job with the poll options, notifying the observers of each status transition
+//
+//	:param ctx: The context used to stop waiting
+//	:param logger: The logger to report progress to, nil for none
+func (jobManagement *JobManagement) waitForJob(ctx context.Context, logger Logger) (jobStatus string, err error) {
+	jobID := jobManagement.getJobID()
+
+	if jobID == 0 {
+		return "new", errors.New("no job has been launched")
+	}
+
+	watch := polling.Watch{Label: "Job", ID: jobID, Observers: jobManagement.observers, Logger: logger}
+
+	return polling.WaitForStatus(ctx, jobManagement.pollOptions.WithDefaults(), watch, func() (string, error) {
+		return jobManagement.job.GetJobStatus(jobID)
+	})
+}
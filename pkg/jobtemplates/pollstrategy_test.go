@@ -0,0 +1,85 @@
+package jobtemplates
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/btr1975/go-ansible-aap-api-client/internal/fakeconnection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/jobs"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newFakeStatusConnection serves job 5 that goes through a sequence of statuses, one per poll
+func newFakeStatusConnection(statuses ...string) *fakeconnection.Connection {
+	polls := 0
+
+	return fakeconnection.New().
+		HandleJSON(http.MethodGet, "jobs/5/job_host_summaries/", jobs.JobHostSummaryResponseSchema{Count: 1, Results: []jobs.JobHostSummaryResponseSingleSchema{{HostName: "sw1", Failures: 1}}}).
+		Handle(http.MethodGet, "jobs/5/", func(fakeconnection.Request) (*http.Response, error) {
+			status := statuses[min(polls, len(statuses)-1)]
+			polls++
+
+			return fakeconnection.JSON(jobs.JobResponseSingleSchema{
+				ID:             5,
+				Status:         status,
+				Elapsed:        1.5,
+				JobExplanation: "Previous Task Failed",
+				Artifacts:      map[string]any{"backup_path": "/tmp/sw1.cfg"},
+			})
+		})
+}
+
+func newTestJobManagement(statuses ...string) *JobManagement {
+	return &JobManagement{
+		job:   jobs.NewJob(newFakeStatusConnection(statuses...)),
+		jobID: 5,
+	}
+}
+
+func TestJobManagement_Wait(t *testing.T) {
+	jobManagement := newTestJobManagement("pending", "waiting", "running", "running", "successful")
+	jobManagement.SetPollOptions(PollOptions{Interval: time.Millisecond, BackoffFactor: 2, MaxInterval: 3 * time.Millisecond})
+
+	transitions := []string{}
+	jobManagement.AddStatusObserver(StatusObserverFunc(func(transition StatusTransition) {
+		if transition.JobID != 5 || transition.Time.IsZero() {
+			t.Errorf("StatusChanged() transition = %+v", transition)
+		}
+
+		transitions = append(transitions, transition.From+">"+transition.To)
+	}))
+
+	output := &bytes.Buffer{}
+	jobManagement.SetLogger(log.New(output, "", 0))
+
+	status, err := jobManagement.Wait(context.Background())
+
+	if err != nil || status != "successful" {
+		t.Fatalf("JobManagement.Wait() = %s, %v, want successful", status, err)
+	}
+
+	want := "new>pending,pending>waiting,waiting>running,running>successful"
+
+	if strings.Join(transitions, ",") != want {
+		t.Errorf("JobManagement.Wait() transitions = %v, want %s", transitions, want)
+	}
+
+	if !strings.Contains(output.String(), "Polling Job ID 5 completed status successful") {
+		t.Errorf("JobManagement.Wait() log = %q", output.String())
+	}
+}
+
+func TestJobManagement_Wait_MaxWait(t *testing.T) {
+	jobManagement := newTestJobManagement("running")
+	jobManagement.SetPollOptions(PollOptions{Interval: time.Millisecond, MaxWait: 20 * time.Millisecond})
+
+	status, err := jobManagement.Wait(context.Background())
+
+	if !errors.Is(err, ErrPollTimeout) || status != "running" {
+		t.Errorf("JobManagement.Wait() = %s, %v, want running and ErrPollTimeout", status, err)
+	}
+}
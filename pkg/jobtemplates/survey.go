@@ -0,0 +1,502 @@
+package jobtemplates
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"math"
+	"slices"
+	"strings"
+)
+
+// Survey question types supported by AAP
+const (
+	SurveyTypeText           = "text"
+	SurveyTypeTextarea       = "textarea"
+	SurveyTypePassword       = "password"
+	SurveyTypeInteger        = "integer"
+	SurveyTypeFloat          = "float"
+	SurveyTypeMultipleChoice = "multiplechoice"
+	SurveyTypeMultiSelect    = "multiselect"
+)
+
+// SurveyEncryptedDefault is the placeholder AAP returns for the default of a password question
+const SurveyEncryptedDefault = "$encrypted$"
+
+// NewSurveySpec creates a new empty survey spec
+//
+//	:param name: The name of the survey
+//	:param description: The description of the survey
+func NewSurveySpec(name string, description string) *SurveySpecSchema {
+	return &SurveySpecSchema{
+		Name:        name,
+		Description: description,
+		Spec:        []SurveyQuestionSchema{},
+	}
+}
+
+// NewTextQuestion creates a text survey question
+//
+//	:param variable: The extra variable the answer is stored in
+//	:param questionName: The question to ask
+//	:param required: Whether an answer is required
+//	:param minLength: The minimum length of the answer
+//	:param maxLength: The maximum length of the answer
+func NewTextQuestion(variable string, questionName string, required bool, minLength int32, maxLength int32) SurveyQuestionSchema {
+	return SurveyQuestionSchema{
+		QuestionName: questionName,
+		Required:     required,
+		Type:         SurveyTypeText,
+		Variable:     variable,
+		Min:          &minLength,
+		Max:          &maxLength,
+	}
+}
+
+// NewPasswordQuestion creates a password survey question
+//
+//	:param variable: The extra variable the answer is stored in
+//	:param questionName: The question to ask
+//	:param required: Whether an answer is required
+//	:param minLength: The minimum length of the answer
+//	:param maxLength: The maximum length of the answer
+func NewPasswordQuestion(variable string, questionName string, required bool, minLength int32, maxLength int32) SurveyQuestionSchema {
+	question := NewTextQuestion(variable, questionName, required, minLength, maxLength)
+	question.Type = SurveyTypePassword
+
+	return question
+}
+
+// NewIntegerQuestion creates an integer survey question
+//
+//	:param variable: The extra variable the answer is stored in
+//	:param questionName: The question to ask
+//	:param required: Whether an answer is required
+//	:param minValue: The minimum value of the answer
+//	:param maxValue: The maximum value of the answer
+func NewIntegerQuestion(variable string, questionName string, required bool, minValue int32, maxValue int32) SurveyQuestionSchema {
+	return SurveyQuestionSchema{
+		QuestionName: questionName,
+		Required:     required,
+		Type:         SurveyTypeInteger,
+		Variable:     variable,
+		Min:          &minValue,
+		Max:          &maxValue,
+	}
+}
+
+// NewMultipleChoiceQuestion creates a multiple choice survey question, exactly one choice can be answered
+//
+//	:param variable: The extra variable the answer is stored in
+//	:param questionName: The question to ask
+//	:param required: Whether an answer is required
+//	:param choices: The choices to pick from
+func NewMultipleChoiceQuestion(variable string, questionName string, required bool, choices []string) SurveyQuestionSchema {
+	return SurveyQuestionSchema{
+		QuestionName: questionName,
+		Required:     required,
+		Type:         SurveyTypeMultipleChoice,
+		Variable:     variable,
+		Choices:      choices,
+	}
+}
+
+// NewMultiSelectQuestion creates a multi select survey question, any number of choices can be answered
+//
+//	:param variable: The extra variable the answer is stored in
+//	:param questionName: The question to ask
+//	:param required: Whether an answer is required
+//	:param choices: The choices to pick from
+func NewMultiSelectQuestion(variable string, questionName string, required bool, choices []string) SurveyQuestionSchema {
+	question := NewMultipleChoiceQuestion(variable, questionName, required, choices)
+	question.Type = SurveyTypeMultiSelect
+
+	return question
+}
+
+// AddQuestion adds a question to a survey spec
+//
+//	:param question: The question to add
+func (surveySpec *SurveySpecSchema) AddQuestion(question SurveyQuestionSchema) (err error) {
+	err = question.validateDefinition()
+
+	if err != nil {
+		return err
+	}
+
+	for _, existing := range surveySpec.Spec {
+		if existing.Variable == question.Variable {
+			return fmt.Errorf("survey variable %s already exists", question.Variable)
+		}
+	}
+
+	surveySpec.Spec = append(surveySpec.Spec, question)
+
+	return nil
+}
+
+// Validate validates the definition of every question of a survey spec
+func (surveySpec *SurveySpecSchema) Validate() (err error) {
+	var errs []error
+	variables := map[string]bool{}
+
+	for _, question := range surveySpec.Spec {
+		if variables[question.Variable] {
+			errs = append(errs, fmt.Errorf("survey variable %s is defined more than once", question.Variable))
+		}
+
+		variables[question.Variable] = true
+
+		errs = append(errs, question.validateDefinition())
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidateExtraVars validates extra variables against the survey spec
+//
+//	:param extraVars: The extra variables to validate
+func (surveySpec *SurveySpecSchema) ValidateExtraVars(extraVars map[string]any) (err error) {
+	var errs []error
+
+	for _, question := range surveySpec.Spec {
+		value, ok := extraVars[question.Variable]
+
+		if !ok || value == nil || value == "" {
+			if question.Required && (question.Default == nil || question.Default == "") {
+				errs = append(errs, fmt.Errorf("survey variable %s is required", question.Variable))
+			}
+
+			continue
+		}
+
+		errs = append(errs, question.validateAnswer(value))
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateDefinition validates the definition of a survey question
+func (question SurveyQuestionSchema) validateDefinition() (err error) {
+	if question.Variable == "" {
+		return fmt.Errorf("survey question %q has no variable", question.QuestionName)
+	}
+
+	if question.QuestionName == "" {
+		return fmt.Errorf("survey variable %s has no question name", question.Variable)
+	}
+
+	switch question.Type {
+	case SurveyTypeText, SurveyTypeTextarea, SurveyTypePassword, SurveyTypeInteger, SurveyTypeFloat:
+		if question.Min != nil && question.Max != nil && *question.Min > *question.Max {
+			return fmt.Errorf("survey variable %s has min %d greater than max %d", question.Variable, *question.Min, *question.Max)
+		}
+	case SurveyTypeMultipleChoice, SurveyTypeMultiSelect:
+		if len(question.Choices) == 0 {
+			return fmt.Errorf("survey variable %s of type %s has no choices", question.Variable, question.Type)
+		}
+	default:
+		return fmt.Errorf("survey variable %s has unsupported type %s", question.Variable, question.Type)
+	}
+
+	if question.Type == SurveyTypePassword && question.Default == SurveyEncryptedDefault {
+		return nil
+	}
+
+	if question.Default != nil && question.Default != "" {
+		err = question.validateAnswer(question.Default)
+
+		if err != nil {
+			return fmt.Errorf("survey variable %s has an invalid default: %w", question.Variable, err)
+		}
+	}
+
+	return nil
+}
+
+// validateAnswer validates a single answer against a survey question
+//
+//	:param value: The answer to validate
+func (question SurveyQuestionSchema) validateAnswer(value any) (err error) {
+	switch question.Type {
+	case SurveyTypeText, SurveyTypeTextarea, SurveyTypePassword:
+		text, ok := value.(string)
+
+		if !ok {
+			return fmt.Errorf("survey variable %s must be a string", question.Variable)
+		}
+
+		return question.validateRange(float64(len([]rune(text))), "length")
+
+	case SurveyTypeInteger:
+		number, ok := toFloat(value)
+
+		if !ok || number != math.Trunc(number) {
+			return fmt.Errorf("survey variable %s must be an integer", question.Variable)
+		}
+
+		return question.validateRange(number, "value")
+
+	case SurveyTypeFloat:
+		number, ok := toFloat(value)
+
+		if !ok {
+			return fmt.Errorf("survey variable %s must be a number", question.Variable)
+		}
+
+		return question.validateRange(number, "value")
+
+	case SurveyTypeMultipleChoice:
+		choice, ok := value.(string)
+
+		if !ok {
+			return fmt.Errorf("survey variable %s must be a string", question.Variable)
+		}
+
+		if !slices.Contains(question.Choices, choice) {
+			return fmt.Errorf("survey variable %s value %q is not one of %v", question.Variable, choice, []string(question.Choices))
+		}
+
+	case SurveyTypeMultiSelect:
+		selected, ok := toStringSlice(value)
+
+		if !ok {
+			return fmt.Errorf("survey variable %s must be a list of strings", question.Variable)
+		}
+
+		for _, choice := range selected {
+			if !slices.Contains(question.Choices, choice) {
+				return fmt.Errorf("survey variable %s value %q is not one of %v", question.Variable, choice, []string(question.Choices))
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateRange validates a length or value against the min and max of a survey question
+//
+//	:param number: The length or value to validate
+//	:param kind: What the number is, used in the error message
+func (question SurveyQuestionSchema) validateRange(number float64, kind string) (err error) {
+	if question.Min != nil && number < float64(*question.Min) {
+		return fmt.Errorf("survey variable %s %s must be at least %d", question.Variable, kind, *question.Min)
+	}
+
+	if question.Max != nil && number > float64(*question.Max) {
+		return fmt.Errorf("survey variable %s %s must be at most %d", question.Variable, kind, *question.Max)
+	}
+
+	return nil
+}
+
+// toFloat converts a decoded number to a float64
+//
+//	:param value: The value to convert
+func toFloat(value any) (number float64, ok bool) {
+	switch typedValue := value.(type) {
+	case int:
+		return float64(typedValue), true
+	case int32:
+		return float64(typedValue), true
+	case int64:
+		return float64(typedValue), true
+	case float32:
+		return float64(typedValue), true
+	case float64:
+		return typedValue, true
+	case json.Number:
+		number, err := typedValue.Float64()
+		return number, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// toStringSlice converts a decoded list or a newline separated string to a slice of strings
+//
+//	:param value: The value to convert
+func toStringSlice(value any) (values []string, ok bool) {
+	switch typedValue := value.(type) {
+	case string:
+		for _, item := range strings.Split(typedValue, "\n") {
+			if item != "" {
+				values = append(values, item)
+			}
+		}
+
+		return values, true
+	case []string:
+		return typedValue, true
+	case []any:
+		for _, item := range typedValue {
+			text, ok := item.(string)
+
+			if !ok {
+				return nil, false
+			}
+
+			values = append(values, text)
+		}
+
+		return values, true
+	default:
+		return nil, false
+	}
+}
+
+// ParseExtraVars parses extra variables given as a JSON or YAML string
+//
+//	:param extraVars: The extra variables to parse
+func ParseExtraVars(extraVars string) (parsed map[string]any, err error) {
+	parsed = map[string]any{}
+
+	if extraVars == "" {
+		return parsed, nil
+	}
+
+	err = yaml.Unmarshal([]byte(extraVars), &parsed)
+
+	if err != nil {
+		return parsed, fmt.Errorf("error parsing extra vars: %w", err)
+	}
+
+	return parsed, nil
+}
+
+// GetSurveySpec gets the survey spec of a job template by ID
+//
+//	:param id: The ID of the job template
+func (jobTemplate *JobTemplate) GetSurveySpec(id int32) (schemaResponse SurveySpecSchema, err error) {
+	schemaResponse = SurveySpecSchema{}
+
+	uri := fmt.Sprintf("%s%d/survey_spec/", jobTemplate.URI, id)
+
+	response, err := jobTemplate.connection.Get(uri, nil)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = jobTemplate.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// SetSurveySpec sets the survey spec of a job template by ID
+//
+//	:param id: The ID of the job template
+//	:param surveySpec: The survey spec to set
+func (jobTemplate *JobTemplate) SetSurveySpec(id int32, surveySpec SurveySpecSchema) (statusCode int, err error) {
+	err = surveySpec.Validate()
+
+	if err != nil {
+		return 0, err
+	}
+
+	uri := fmt.Sprintf("%s%d/survey_spec/", jobTemplate.URI, id)
+
+	data, err := json.Marshal(surveySpec)
+
+	if err != nil {
+		return 0, err
+	}
+
+	response, err := jobTemplate.connection.Post(uri, data)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer response.Body.Close()
+
+	return response.StatusCode, nil
+}
+
+// DeleteSurveySpec deletes the survey spec of a job template by ID
+//
+//	:param id: The ID of the job template
+func (jobTemplate *JobTemplate) DeleteSurveySpec(id int32) (statusCode int, err error) {
+	uri := fmt.Sprintf("%s%d/survey_spec/", jobTemplate.URI, id)
+
+	response, err := jobTemplate.connection.Delete(uri, nil)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response.StatusCode, nil
+}
+
+// EnableSurvey enables or disables the survey of a job template by ID
+//
+//	:param id: The ID of the job template
+//	:param enabled: Whether the survey is enabled
+func (jobTemplate *JobTemplate) EnableSurvey(id int32, enabled bool) (statusCode int, err error) {
+	uri := fmt.Sprintf("%s%d/", jobTemplate.URI, id)
+
+	data, err := json.Marshal(map[string]bool{"survey_enabled": enabled})
+
+	if err != nil {
+		return 0, err
+	}
+
+	response, err := jobTemplate.connection.Patch(uri, data)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer response.Body.Close()
+
+	return response.StatusCode, nil
+}
+
+// ValidateSurveyAnswers validates extra variables against the survey of a job template when the survey is enabled
+//
+//	:param id: The ID of the job template
+//	:param extraVars: The extra variables to validate
+func (jobTemplate *JobTemplate) ValidateSurveyAnswers(id int32, extraVars map[string]any) (err error) {
+	jobTemplateData, err := jobTemplate.GetJobTemplateByID(id)
+
+	if err != nil {
+		return err
+	}
+
+	if !jobTemplateData.SurveyEnabled {
+		return nil
+	}
+
+	surveySpec, err := jobTemplate.GetSurveySpec(id)
+
+	if err != nil {
+		return err
+	}
+
+	return surveySpec.ValidateExtraVars(extraVars)
+}
+
+// LaunchJobTemplateWithSurvey validates the extra variables against the survey then launches a job template by ID
+//
+//	:param id: The ID of the job template to launch
+//	:param launchData: The launch data
+func (jobTemplate *JobTemplate) LaunchJobTemplateWithSurvey(id int32, launchData JobTemplateSimpleRequestSchema) (schemaResponse JobTemplateResponseSingleSchema, err error) {
+	extraVars, err := ParseExtraVars(launchData.ExtraVars)
+
+	if err != nil {
+		return JobTemplateResponseSingleSchema{}, err
+	}
+
+	err = jobTemplate.ValidateSurveyAnswers(id, extraVars)
+
+	if err != nil {
+		return JobTemplateResponseSingleSchema{}, err
+	}
+
+	return jobTemplate.LaunchJobTemplate(id, launchData)
+}
@@ -0,0 +1,60 @@
+package jobtemplates
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// SurveyChoices are the choices of a multiple choice or multi select survey question
+//
+// AAP returns the choices either as a list or as a newline separated string, both are accepted
+type SurveyChoices []string
+
+// UnmarshalJSON decodes survey choices from a list or a newline separated string
+//
+//	:param data: The JSON data to decode
+func (choices *SurveyChoices) UnmarshalJSON(data []byte) error {
+	var choicesList []string
+
+	if err := json.Unmarshal(data, &choicesList); err == nil {
+		*choices = choicesList
+		return nil
+	}
+
+	var choicesString string
+
+	if err := json.Unmarshal(data, &choicesString); err != nil {
+		return err
+	}
+
+	*choices = SurveyChoices{}
+
+	for _, choice := range strings.Split(choicesString, "\n") {
+		if choice != "" {
+			*choices = append(*choices, choice)
+		}
+	}
+
+	return nil
+}
+
+// SurveyQuestionSchema is the schema for a single survey question
+type SurveyQuestionSchema struct {
+	QuestionName        string        `json:"question_name" yaml:"question_name"`
+	QuestionDescription string        `json:"question_description" yaml:"question_description"`
+	Required            bool          `json:"required" yaml:"required"`
+	Type                string        `json:"type" yaml:"type"`
+	Variable            string        `json:"variable" yaml:"variable"`
+	Min                 *int32        `json:"min,omitempty" yaml:"min,omitempty"`
+	Max                 *int32        `json:"max,omitempty" yaml:"max,omitempty"`
+	Default             any           `json:"default,omitempty" yaml:"default,omitempty"`
+	Choices             SurveyChoices `json:"choices,omitempty" yaml:"choices,omitempty"`
+	NewQuestion         bool          `json:"new_question,omitempty" yaml:"new_question,omitempty"`
+}
+
+// SurveySpecSchema is the schema for a survey spec
+type SurveySpecSchema struct {
+	Name        string                 `json:"name" yaml:"name"`
+	Description string                 `json:"description" yaml:"description"`
+	Spec        []SurveyQuestionSchema `json:"spec" yaml:"spec"`
+}
@@ -0,0 +1,181 @@
+package jobtemplates
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func newTestSurveySpec(t *testing.T) *SurveySpecSchema {
+	surveySpec := NewSurveySpec("Change", "Change details")
+
+	questions := []SurveyQuestionSchema{
+		NewTextQuestion("change_id", "Change ID", true, 3, 10),
+		NewPasswordQuestion("enable_secret", "Enable secret", false, 8, 64),
+		NewIntegerQuestion("batch_size", "Batch size", false, 1, 50),
+		NewMultipleChoiceQuestion("site", "Site", true, []string{"dc1", "dc2"}),
+		NewMultiSelectQuestion("roles", "Roles", false, []string{"core", "edge", "access"}),
+	}
+
+	for _, question := range questions {
+		if err := surveySpec.AddQuestion(question); err != nil {
+			t.Fatalf("AddQuestion() error = %v", err)
+		}
+	}
+
+	return surveySpec
+}
+
+func TestSurveySpecSchema_AddQuestion(t *testing.T) {
+	surveySpec := newTestSurveySpec(t)
+	tokenLength := int32(32)
+
+	tests := []struct {
+		name     string
+		question SurveyQuestionSchema
+		wantErr  bool
+	}{
+		{
+			name:     "Test AddQuestion duplicate variable",
+			question: NewTextQuestion("change_id", "Change ID again", false, 0, 10),
+			wantErr:  true,
+		},
+		{
+			name:     "Test AddQuestion no choices",
+			question: NewMultipleChoiceQuestion("empty", "Empty", false, nil),
+			wantErr:  true,
+		},
+		{
+			name:     "Test AddQuestion min greater than max",
+			question: NewIntegerQuestion("bad_range", "Bad range", false, 10, 1),
+			wantErr:  true,
+		},
+		{
+			name:     "Test AddQuestion unsupported type",
+			question: SurveyQuestionSchema{QuestionName: "Date", Variable: "date", Type: "date"},
+			wantErr:  true,
+		},
+		{
+			name:     "Test AddQuestion valid",
+			question: NewTextQuestion("ticket", "Ticket", false, 0, 20),
+			wantErr:  false,
+		},
+		{
+			name:     "Test AddQuestion encrypted password default",
+			question: SurveyQuestionSchema{QuestionName: "Token", Variable: "token", Type: SurveyTypePassword, Min: &tokenLength, Max: &tokenLength, Default: SurveyEncryptedDefault},
+			wantErr:  false,
+		},
+		{
+			name:     "Test AddQuestion encrypted text default",
+			question: SurveyQuestionSchema{QuestionName: "Secret", Variable: "secret", Type: SurveyTypeText, Min: &tokenLength, Max: &tokenLength, Default: SurveyEncryptedDefault},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := surveySpec.AddQuestion(tt.question); (err != nil) != tt.wantErr {
+				t.Errorf("SurveySpecSchema.AddQuestion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSurveySpecSchema_ValidateExtraVars(t *testing.T) {
+	surveySpec := newTestSurveySpec(t)
+
+	tests := []struct {
+		name      string
+		extraVars string
+		wantErr   bool
+	}{
+		{
+			name:      "Test ValidateExtraVars valid",
+			extraVars: `{"change_id": "CHG123", "batch_size": 5, "site": "dc1", "roles": ["core", "edge"]}`,
+			wantErr:   false,
+		},
+		{
+			name:      "Test ValidateExtraVars valid YAML",
+			extraVars: "change_id: CHG123\nsite: dc2\nroles:\n  - access\n",
+			wantErr:   false,
+		},
+		{
+			name:      "Test ValidateExtraVars missing required",
+			extraVars: `{"site": "dc1"}`,
+			wantErr:   true,
+		},
+		{
+			name:      "Test ValidateExtraVars text too short",
+			extraVars: `{"change_id": "C1", "site": "dc1"}`,
+			wantErr:   true,
+		},
+		{
+			name:      "Test ValidateExtraVars password too short",
+			extraVars: `{"change_id": "CHG123", "site": "dc1", "enable_secret": "short"}`,
+			wantErr:   true,
+		},
+		{
+			name:      "Test ValidateExtraVars integer out of range",
+			extraVars: `{"change_id": "CHG123", "site": "dc1", "batch_size": 500}`,
+			wantErr:   true,
+		},
+		{
+			name:      "Test ValidateExtraVars integer not whole",
+			extraVars: `{"change_id": "CHG123", "site": "dc1", "batch_size": 2.5}`,
+			wantErr:   true,
+		},
+		{
+			name:      "Test ValidateExtraVars invalid choice",
+			extraVars: `{"change_id": "CHG123", "site": "dc3"}`,
+			wantErr:   true,
+		},
+		{
+			name:      "Test ValidateExtraVars invalid multi select",
+			extraVars: `{"change_id": "CHG123", "site": "dc1", "roles": ["core", "spine"]}`,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			extraVars, err := ParseExtraVars(tt.extraVars)
+
+			if err != nil {
+				t.Fatalf("ParseExtraVars() error = %v", err)
+			}
+
+			if err := surveySpec.ValidateExtraVars(extraVars); (err != nil) != tt.wantErr {
+				t.Errorf("SurveySpecSchema.ValidateExtraVars() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSurveyChoices_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want []string
+	}{
+		{
+			name: "Test SurveyChoices list",
+			data: `["a", "b"]`,
+			want: []string{"a", "b"},
+		},
+		{
+			name: "Test SurveyChoices newline string",
+			data: `"a\nb\n"`,
+			want: []string{"a", "b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var choices SurveyChoices
+
+			if err := json.Unmarshal([]byte(tt.data), &choices); err != nil {
+				t.Fatalf("SurveyChoices.UnmarshalJSON() error = %v", err)
+			}
+
+			if len(choices) != len(tt.want) || choices[0] != tt.want[0] || choices[1] != tt.want[1] {
+				t.Errorf("SurveyChoices.UnmarshalJSON() = %v, want %v", choices, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package organizations
+
+import (
+	"fmt"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/common"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/inventories"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/projects"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/users"
+)
+
+// relatedURI gets the URI of a related endpoint of an organization
+//
+//	:param id: The ID of the organization
+//	:param related: The name of the related endpoint
+func (organization *Organization) relatedURI(id int32, related string) string {
+	return fmt.Sprintf("%s%d/%s/", organization.URI, id, related)
+}
+
+// GetUsers gets the users of an organization
+//
+//	:param id: The ID of the organization
+func (organization *Organization) GetUsers(id int32) (userList []users.UserResponseSingleSchema, err error) {
+	return common.GetAllPages[users.UserResponseSingleSchema](organization.connection, organization.DataConversion, organization.relatedURI(id, "users"), nil)
+}
+
+// GetAdmins gets the admins of an organization
+//
+//	:param id: The ID of the organization
+func (organization *Organization) GetAdmins(id int32) (admins []users.UserResponseSingleSchema, err error) {
+	return common.GetAllPages[users.UserResponseSingleSchema](organization.connection, organization.DataConversion, organization.relatedURI(id, "admins"), nil)
+}
+
+// GetTeams gets the teams of an organization
+//
+//	:param id: The ID of the organization
+func (organization *Organization) GetTeams(id int32) (teams []users.TeamResponseSingleSchema, err error) {
+	return common.GetAllPages[users.TeamResponseSingleSchema](organization.connection, organization.DataConversion, organization.relatedURI(id, "teams"), nil)
+}
+
+// GetInventories gets the inventories of an organization
+//
+//	:param id: The ID of the organization
+func (organization *Organization) GetInventories(id int32) (inventoryList []inventories.InventoryResponseSingleSchema, err error) {
+	return common.GetAllPages[inventories.InventoryResponseSingleSchema](organization.connection, organization.DataConversion, organization.relatedURI(id, "inventories"), nil)
+}
+
+// GetProjects gets the projects of an organization
+//
+//	:param id: The ID of the organization
+func (organization *Organization) GetProjects(id int32) (projectList []projects.ProjectResponseSingleSchema, err error) {
+	return common.GetAllPages[projects.ProjectResponseSingleSchema](organization.connection, organization.DataConversion, organization.relatedURI(id, "projects"), nil)
+}
+
+// GetCredentials gets the credentials of an organization
+//
+//	:param id: The ID of the organization
+func (organization *Organization) GetCredentials(id int32) (credentials []OrganizationResourceSchema, err error) {
+	return common.GetAllPages[OrganizationResourceSchema](organization.connection, organization.DataConversion, organization.relatedURI(id, "credentials"), nil)
+}
+
+// GetExecutionEnvironments gets the execution environments of an organization
+//
+//	:param id: The ID of the organization
+func (organization *Organization) GetExecutionEnvironments(id int32) (executionEnvironments []OrganizationResourceSchema, err error) {
+	return common.GetAllPages[OrganizationResourceSchema](organization.connection, organization.DataConversion, organization.relatedURI(id, "execution_environments"), nil)
+}
+
+// GetNotificationTemplates gets the notification templates of an organization
+//
+//	:param id: The ID of the organization
+func (organization *Organization) GetNotificationTemplates(id int32) (notificationTemplates []OrganizationResourceSchema, err error) {
+	return common.GetAllPages[OrganizationResourceSchema](organization.connection, organization.DataConversion, organization.relatedURI(id, "notification_templates"), nil)
+}
+
+// AddUser adds a user to an organization
+//
+//	:param id: The ID of the organization
+//	:param userID: The ID of the user to add
+func (organization *Organization) AddUser(id int32, userID int32) (statusCode int, err error) {
+	return common.Associate(organization.connection, organization.relatedURI(id, "users"), userID, false)
+}
+
+// RemoveUser removes a user from an organization
+//
+//	:param id: The ID of the organization
+//	:param userID: The ID of the user to remove
+func (organization *Organization) RemoveUser(id int32, userID int32) (statusCode int, err error) {
+	return common.Associate(organization.connection, organization.relatedURI(id, "users"), userID, true)
+}
+
+// AddAdmin makes a user an admin of an organization
+//
+//	:param id: The ID of the organization
+//	:param userID: The ID of the user to make an admin
+func (organization *Organization) AddAdmin(id int32, userID int32) (statusCode int, err error) {
+	return common.Associate(organization.connection, organization.relatedURI(id, "admins"), userID, false)
+}
+
+// RemoveAdmin removes a user from the admins of an organization
+//
+//	:param id: The ID of the organization
+//	:param userID: The ID of the admin to remove
+func (organization *Organization) RemoveAdmin(id int32, userID int32) (statusCode int, err error) {
+	return common.Associate(organization.connection, organization.relatedURI(id, "admins"), userID, true)
+}
+
+// Onboard onboards a team into its own organization, creating the organization and the team when they do not exist,
+// making the admins organization admins and the members organization and team members
+//
+// Running it again with the same request changes nothing, so it can be used to add admins and members later.
+//
+//	:param onboardingRequest: The onboarding request schema to use
+func (organization *Organization) Onboard(onboardingRequest OnboardingRequestSchema) (result OnboardingResultSchema, err error) {
+	existing, err := organization.GetOrganization(onboardingRequest.Organization.Name)
+
+	if err != nil {
+		return result, err
+	}
+
+	if len(existing.Results) > 0 {
+		result.OrganizationID = existing.Results[0].ID
+	} else {
+		created, err := organization.CreateOrganization(onboardingRequest.Organization)
+
+		if err != nil {
+			return result, err
+		}
+
+		result.OrganizationID = created.ID
+		result.OrganizationCreated = true
+	}
+
+	team := users.NewTeam(organization.connection)
+	user := users.NewUser(organization.connection)
+
+	existingTeam, err := team.GetTeam(onboardingRequest.Team.Name, result.OrganizationID)
+
+	if err != nil {
+		return result, err
+	}
+
+	if len(existingTeam.Results) > 0 {
+		result.TeamID = existingTeam.Results[0].ID
+	} else {
+		teamRequest := onboardingRequest.Team
+		teamRequest.Organization = result.OrganizationID
+
+		created, err := team.CreateTeam(teamRequest)
+
+		if err != nil {
+			return result, err
+		}
+
+		result.TeamID = created.ID
+		result.TeamCreated = true
+	}
+
+	for _, username := range onboardingRequest.Admins {
+		userID, err := user.GetUserID(username)
+
+		if err != nil {
+			return result, err
+		}
+
+		if _, err = organization.AddAdmin(result.OrganizationID, userID); err != nil {
+			return result, fmt.Errorf("error making %s an admin of organization %d: %w", username, result.OrganizationID, err)
+		}
+	}
+
+	for _, username := range onboardingRequest.Members {
+		userID, err := user.GetUserID(username)
+
+		if err != nil {
+			return result, err
+		}
+
+		if _, err = organization.AddUser(result.OrganizationID, userID); err != nil {
+			return result, fmt.Errorf("error adding %s to organization %d: %w", username, result.OrganizationID, err)
+		}
+
+		if _, err = team.AddTeamMember(result.TeamID, userID); err != nil {
+			return result, fmt.Errorf("error adding %s to team %d: %w", username, result.TeamID, err)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,152 @@
+package organizations
+
+import (
+	"github.com/btr1975/go-ansible-aap-api-client/internal/fakeconnection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/common"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/users"
+	"net/http"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// fakeOnboardingServer keeps organizations, teams and associations in memory
+type fakeOnboardingServer struct {
+	*fakeconnection.Connection
+	organizations []OrganizationResponseSingleSchema
+	teams         []users.TeamResponseSingleSchema
+	users         map[string]int32
+	associations  map[string][]int32
+}
+
+func newFakeOnboardingServer(userIDs map[string]int32) *fakeOnboardingServer {
+	server := &fakeOnboardingServer{Connection: fakeconnection.New(), users: userIDs, associations: map[string][]int32{}}
+
+	server.Handle(http.MethodGet, "organizations/", server.getOrganizations).
+		Handle(http.MethodGet, "users/", server.getUsers).
+		Handle(http.MethodGet, "teams/", server.getTeams).
+		Handle(http.MethodPost, "organizations/", server.createOrganization).
+		Handle(http.MethodPost, "teams/", server.createTeam).
+		HandlePrefix(http.MethodPost, "organizations/", server.associate).
+		HandlePrefix(http.MethodPost, "teams/", server.associate)
+
+	return server
+}
+
+// creates counts the organizations and teams created
+func (server *fakeOnboardingServer) creates() int {
+	return len(slices.DeleteFunc(server.Calls(http.MethodPost), func(call string) bool {
+		return call != "POST organizations/" && call != "POST teams/"
+	}))
+}
+
+func (server *fakeOnboardingServer) getOrganizations(request fakeconnection.Request) (*http.Response, error) {
+	results := []OrganizationResponseSingleSchema{}
+
+	for _, organization := range server.organizations {
+		if organization.Name == request.Params["name"] {
+			results = append(results, organization)
+		}
+	}
+
+	return fakeconnection.JSON(OrganizationResponseSchema{Count: int32(len(results)), Results: results})
+}
+
+func (server *fakeOnboardingServer) getUsers(request fakeconnection.Request) (*http.Response, error) {
+	results := []users.UserResponseSingleSchema{}
+
+	if id, ok := server.users[request.Params["username"]]; ok {
+		results = append(results, users.UserResponseSingleSchema{ID: id, UserRequestSchema: users.UserRequestSchema{Username: request.Params["username"]}})
+	}
+
+	return fakeconnection.JSON(users.UserResponseSchema{Count: int32(len(results)), Results: results})
+}
+
+func (server *fakeOnboardingServer) getTeams(request fakeconnection.Request) (*http.Response, error) {
+	results := []users.TeamResponseSingleSchema{}
+
+	for _, team := range server.teams {
+		if team.Name == request.Params["name"] && strconv.Itoa(int(team.Organization)) == request.Params["organization"] {
+			results = append(results, team)
+		}
+	}
+
+	return fakeconnection.JSON(users.TeamResponseSchema{Count: int32(len(results)), Results: results})
+}
+
+func (server *fakeOnboardingServer) createOrganization(request fakeconnection.Request) (*http.Response, error) {
+	organizationRequest := OrganizationRequestSchema{}
+	_ = request.Decode(&organizationRequest)
+	organization := OrganizationResponseSingleSchema{ID: 3, OrganizationRequestSchema: organizationRequest}
+	server.organizations = append(server.organizations, organization)
+
+	return fakeconnection.JSON(organization)
+}
+
+func (server *fakeOnboardingServer) createTeam(request fakeconnection.Request) (*http.Response, error) {
+	teamRequest := users.TeamRequestSchema{}
+	_ = request.Decode(&teamRequest)
+	team := users.TeamResponseSingleSchema{ID: 8, TeamRequestSchema: teamRequest}
+	server.teams = append(server.teams, team)
+
+	return fakeconnection.JSON(team)
+}
+
+func (server *fakeOnboardingServer) associate(request fakeconnection.Request) (*http.Response, error) {
+	association := common.AssociationRequestSchema{}
+	_ = request.Decode(&association)
+
+	if !slices.Contains(server.associations[request.URI], association.ID) {
+		server.associations[request.URI] = append(server.associations[request.URI], association.ID)
+	}
+
+	return fakeconnection.NoContent()
+}
+
+func TestOrganization_Onboard(t *testing.T) {
+	connection := newFakeOnboardingServer(map[string]int32{"alice": 11, "bob": 12, "carol": 13})
+	organization := NewOrganization(connection)
+
+	request := OnboardingRequestSchema{
+		Organization: OrganizationRequestSchema{Name: "Network Engineering"},
+		Team:         users.TeamRequestSchema{Name: "Campus"},
+		Admins:       []string{"alice"},
+		Members:      []string{"bob", "carol"},
+	}
+
+	result, err := organization.Onboard(request)
+
+	if err != nil {
+		t.Fatalf("Organization.Onboard() error = %v", err)
+	}
+
+	want := OnboardingResultSchema{OrganizationID: 3, OrganizationCreated: true, TeamID: 8, TeamCreated: true}
+
+	if result != want {
+		t.Errorf("Organization.Onboard() = %+v, want %+v", result, want)
+	}
+
+	wantAssociations := map[string][]int32{
+		"organizations/3/admins/": {11},
+		"organizations/3/users/":  {12, 13},
+		"teams/8/users/":          {12, 13},
+	}
+
+	for uri, ids := range wantAssociations {
+		if !slices.Equal(connection.associations[uri], ids) {
+			t.Errorf("Organization.Onboard() associations %s = %v, want %v", uri, connection.associations[uri], ids)
+		}
+	}
+
+	result, err = organization.Onboard(request)
+
+	if err != nil || result.OrganizationCreated || result.TeamCreated || connection.creates() != 2 {
+		t.Errorf("Organization.Onboard() second run = %+v, err = %v, creates = %d", result, err, connection.creates())
+	}
+
+	request.Members = append(request.Members, "mallory")
+
+	if _, err = organization.Onboard(request); err == nil {
+		t.Errorf("Organization.Onboard() error = nil, want unknown user error")
+	}
+}
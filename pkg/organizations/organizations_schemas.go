@@ -1,5 +1,7 @@
 package organizations
 
+import "github.com/btr1975/go-ansible-aap-api-client/pkg/users"
+
 // OrganizationRequestSchema is the schema for an organization request
 type OrganizationRequestSchema struct {
 	Name               string `json:"name" yaml:"name"`
@@ -51,3 +53,34 @@ type OrganizationResponseSchema struct {
 	Previous string                             `json:"previous" yaml:"previous"`
 	Results  []OrganizationResponseSingleSchema `json:"results" yaml:"results"`
 }
+
+// OrganizationResourceSchema is the schema for the fields common to the credentials, execution environments and
+// notification templates of an organization
+type OrganizationResourceSchema struct {
+	ID          int32  `json:"id" yaml:"id"`
+	Type        string `json:"type" yaml:"type"`
+	URL         string `json:"url" yaml:"url"`
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description" yaml:"description"`
+	Created     string `json:"created" yaml:"created"`
+	Modified    string `json:"modified" yaml:"modified"`
+}
+
+// OnboardingRequestSchema is the schema for onboarding a team into its own organization
+type OnboardingRequestSchema struct {
+	Organization OrganizationRequestSchema `json:"organization" yaml:"organization"`
+	// Team is the team to onboard, its organization is set to the onboarded organization
+	Team users.TeamRequestSchema `json:"team" yaml:"team"`
+	// Admins are the usernames of the organization admins
+	Admins []string `json:"admins" yaml:"admins"`
+	// Members are the usernames of the team members, they are also made members of the organization
+	Members []string `json:"members" yaml:"members"`
+}
+
+// OnboardingResultSchema is the schema for the result of onboarding a team into its own organization
+type OnboardingResultSchema struct {
+	OrganizationID      int32 `json:"organization_id" yaml:"organization_id"`
+	OrganizationCreated bool  `json:"organization_created" yaml:"organization_created"`
+	TeamID              int32 `json:"team_id" yaml:"team_id"`
+	TeamCreated         bool  `json:"team_created" yaml:"team_created"`
+}
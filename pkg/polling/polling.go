@@ -0,0 +1,194 @@
+/*
+Package polling provides a way to poll Ansible AAP jobs, updates, commands and approvals until they finish
+*/
+package polling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/jobs"
+	"time"
+)
+
+// ErrTimeout is returned when polling does not finish within the maximum wait
+var ErrTimeout = errors.New("timed out polling")
+
+// Options are the options used to poll
+type Options struct {
+	// Interval is the time between polls, it must be positive
+	Interval time.Duration
+	// BackoffFactor multiplies the interval after each poll, defaults to 1 which keeps the interval fixed
+	BackoffFactor float64
+	// MaxInterval caps the interval when backing off, 0 means no cap
+	MaxInterval time.Duration
+	// MaxWait is the maximum time to poll, 0 means no limit
+	MaxWait time.Duration
+}
+
+// WithDefaults returns the options with a 5 second interval when none is set
+func (options Options) WithDefaults() Options {
+	if options.Interval <= 0 {
+		options.Interval = 5 * time.Second
+	}
+
+	return options
+}
+
+// NextInterval gets the interval to wait after a poll
+//
+//	:param interval: The interval waited after the previous poll
+func (options Options) NextInterval(interval time.Duration) time.Duration {
+	if options.BackoffFactor > 1 {
+		interval = time.Duration(float64(interval) * options.BackoffFactor)
+	}
+
+	if options.MaxInterval > 0 && interval > options.MaxInterval {
+		interval = options.MaxInterval
+	}
+
+	return interval
+}
+
+// StatusTransition is a change of the status of a polled job
+type StatusTransition struct {
+	JobID   int32
+	From    string
+	To      string
+	Time    time.Time
+	Elapsed time.Duration
+}
+
+// StatusObserver receives the status transitions of a polled job
+type StatusObserver interface {
+	StatusChanged(transition StatusTransition)
+}
+
+// StatusObserverFunc is a function that can be used as a StatusObserver
+type StatusObserverFunc func(transition StatusTransition)
+
+// StatusChanged calls the function with the status transition
+func (observerFunc StatusObserverFunc) StatusChanged(transition StatusTransition) {
+	observerFunc(transition)
+}
+
+// Logger is the logger used to report polling progress, a *log.Logger satisfies it
+type Logger interface {
+	Printf(format string, v ...any)
+}
+
+// StdoutLogger prints to stdout, used when printing the status is asked for and no logger is set
+type StdoutLogger struct{}
+
+// Printf prints a line to stdout
+func (StdoutLogger) Printf(format string, v ...any) {
+	fmt.Printf(format+"\n", v...)
+}
+
+// Watch describes the job whose status is polled and who is told about it
+type Watch struct {
+	// Label names the job in the log, for example Job or Workflow Job
+	Label string
+	ID    int32
+	// Observers are notified of each status transition
+	Observers []StatusObserver
+	// Logger reports the progress, nil for none
+	Logger Logger
+}
+
+// Poll calls check until it reports done, the maximum wait is reached or the context is done
+//
+//	:param ctx: The context used to stop polling
+//	:param options: The poll options
+//	:param check: The function called on each poll, returns true when polling is done
+func Poll(ctx context.Context, options Options, check func() (done bool, err error)) (err error) {
+	if options.Interval <= 0 {
+		return fmt.Errorf("poll interval must be positive, got %s", options.Interval)
+	}
+
+	interval := options.Interval
+	start := time.Now()
+
+	if options.MaxWait > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.MaxWait)
+		defer cancel()
+	}
+
+	for {
+		done, err := check()
+
+		if err != nil {
+			return err
+		}
+
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) && options.MaxWait > 0 && time.Since(start) >= options.MaxWait {
+				return fmt.Errorf("%w after %s", ErrTimeout, options.MaxWait)
+			}
+
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+
+		interval = options.NextInterval(interval)
+	}
+}
+
+// WaitForStatus polls the status of a job until it is finished, notifying the observers of each status transition
+//
+//	:param ctx: The context used to stop polling
+//	:param options: The poll options
+//	:param watch: The job to watch and who to tell about it
+//	:param getStatus: The function that gets the current status of the job
+func WaitForStatus(ctx context.Context, options Options, watch Watch, getStatus func() (string, error)) (status string, err error) {
+	status = "new"
+	start := time.Now()
+
+	if watch.Logger != nil {
+		watch.Logger.Printf("Polling %s ID %d current status %s", watch.Label, watch.ID, status)
+	}
+
+	err = Poll(ctx, options, func() (bool, error) {
+		currentStatus, err := getStatus()
+
+		if err != nil {
+			return false, err
+		}
+
+		if currentStatus != status {
+			now := time.Now()
+
+			for _, observer := range watch.Observers {
+				observer.StatusChanged(StatusTransition{JobID: watch.ID, From: status, To: currentStatus, Time: now, Elapsed: now.Sub(start)})
+			}
+		}
+
+		status = currentStatus
+
+		if watch.Logger != nil {
+			watch.Logger.Printf("Polling %s ID %d current status %s", watch.Label, watch.ID, status)
+		}
+
+		return jobs.IsFinishedStatus(status), nil
+	})
+
+	if errors.Is(err, ErrTimeout) {
+		return status, fmt.Errorf("%s %d still %s: %w", watch.Label, watch.ID, status, err)
+	}
+
+	if err != nil {
+		return status, err
+	}
+
+	if watch.Logger != nil {
+		watch.Logger.Printf("Polling %s ID %d completed status %s", watch.Label, watch.ID, status)
+	}
+
+	return status, nil
+}
@@ -0,0 +1,61 @@
+package polling
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestOptions_NextInterval(t *testing.T) {
+	options := Options{Interval: time.Second, BackoffFactor: 2, MaxInterval: 5 * time.Second}.WithDefaults()
+
+	got := []time.Duration{}
+	interval := options.Interval
+
+	for i := 0; i < 4; i++ {
+		interval = options.NextInterval(interval)
+		got = append(got, interval)
+	}
+
+	if got[0] != 2*time.Second || got[1] != 4*time.Second || got[2] != 5*time.Second || got[3] != 5*time.Second {
+		t.Errorf("Options.NextInterval() = %v", got)
+	}
+}
+
+func TestPoll(t *testing.T) {
+	polls := 0
+
+	err := Poll(context.Background(), Options{Interval: time.Millisecond}, func() (bool, error) {
+		polls++
+
+		return polls == 3, nil
+	})
+
+	if err != nil || polls != 3 {
+		t.Errorf("Poll() = %v after %d polls, want nil after 3", err, polls)
+	}
+
+	for _, interval := range []time.Duration{0, -time.Second} {
+		if err := Poll(context.Background(), Options{Interval: interval}, func() (bool, error) { return true, nil }); err == nil {
+			t.Errorf("Poll(interval %s) error = nil, want non-positive interval error", interval)
+		}
+	}
+
+	err = Poll(context.Background(), Options{Interval: time.Millisecond, MaxWait: 10 * time.Millisecond}, func() (bool, error) {
+		return false, nil
+	})
+
+	if !errors.Is(err, ErrTimeout) {
+		t.Errorf("Poll() error = %v, want ErrTimeout", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err = Poll(ctx, Options{Interval: time.Hour}, func() (bool, error) { return false, nil })
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Poll() error = %v, want context.Canceled", err)
+	}
+}
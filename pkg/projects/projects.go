@@ -0,0 +1,348 @@
+/*
+Package projects provides a way to manipulate projects and project updates for Ansible AAP
+*/
+package projects
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/connection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/dataconversion"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/polling"
+	"io"
+)
+
+// Project represents an AAP project
+type Project struct {
+	URI              string
+	ProjectUpdateURI string
+	connection       connection.BasicConnection
+	DataConversion   dataconversion.DataConverterInterface
+}
+
+// NewProject creates a new project instance
+//
+//	:param basicConnection: The basic connection to use
+func NewProject(basicConnection connection.BasicConnection) *Project {
+	return &Project{
+		URI:              "projects/",
+		ProjectUpdateURI: "project_updates/",
+		connection:       basicConnection,
+		DataConversion:   dataconversion.NewDataConverter(),
+	}
+}
+
+// GetAllProjects gets all projects
+func (project *Project) GetAllProjects() (schemaResponse ProjectResponseSchema, err error) {
+	schemaResponse = ProjectResponseSchema{}
+
+	response, err := project.connection.Get(project.URI, nil)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = project.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// GetProject gets a project by name
+//
+//	:param name: The name of the project to get
+func (project *Project) GetProject(name string) (schemaResponse ProjectResponseSchema, err error) {
+	schemaResponse = ProjectResponseSchema{}
+
+	params := map[string]string{
+		"name": name,
+	}
+
+	response, err := project.connection.Get(project.URI, params)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = project.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// GetProjectID gets a project ID by name
+//
+//	:param name: The name of the project to get
+func (project *Project) GetProjectID(name string) (id int32, err error) {
+	schemaResponse, err := project.GetProject(name)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if len(schemaResponse.Results) > 1 {
+		return 0, fmt.Errorf("more than one project found with name %s", name)
+	}
+
+	if len(schemaResponse.Results) == 0 {
+		return 0, fmt.Errorf("no project found with name %s", name)
+	}
+
+	return schemaResponse.Results[0].ID, nil
+}
+
+// GetProjectByID gets a project by ID
+//
+//	:param id: The ID of the project to get
+func (project *Project) GetProjectByID(id int32) (schemaResponse ProjectResponseSingleSchema, err error) {
+	schemaResponse = ProjectResponseSingleSchema{}
+
+	uri := fmt.Sprintf("%s%d/", project.URI, id)
+
+	response, err := project.connection.Get(uri, nil)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = project.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// CreateProject creates a new project
+//
+//	:param projectRequest: The project request schema to use
+func (project *Project) CreateProject(projectRequest ProjectRequestSchema) (schemaResponse ProjectResponseSingleSchema, err error) {
+	schemaResponse = ProjectResponseSingleSchema{}
+
+	data, err := json.Marshal(projectRequest)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	response, err := project.connection.Post(project.URI, data)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = project.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// UpdateProject updates a project by ID
+//
+//	:param id: The ID of the project to update
+//	:param projectRequest: The project request schema to use
+func (project *Project) UpdateProject(id int32, projectRequest ProjectRequestSchema) (schemaResponse ProjectResponseSingleSchema, err error) {
+	schemaResponse = ProjectResponseSingleSchema{}
+
+	uri := fmt.Sprintf("%s%d/", project.URI, id)
+
+	data, err := json.Marshal(projectRequest)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	response, err := project.connection.Patch(uri, data)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = project.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// DeleteProject deletes a project by ID
+//
+//	:param id: The ID of the project to delete
+func (project *Project) DeleteProject(id int32) (statusCode int, err error) {
+	uri := fmt.Sprintf("%s%d/", project.URI, id)
+
+	response, err := project.connection.Delete(uri, nil)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response.StatusCode, nil
+}
+
+// GetPlaybooks gets the playbooks available in a project by ID
+//
+//	:param id: The ID of the project to get the playbooks for
+func (project *Project) GetPlaybooks(id int32) (playbooks []string, err error) {
+	playbooks = []string{}
+
+	uri := fmt.Sprintf("%s%d/playbooks/", project.URI, id)
+
+	response, err := project.connection.Get(uri, nil)
+
+	if err != nil {
+		return playbooks, err
+	}
+
+	err = project.DataConversion.ResponseBodyToStruct(&playbooks, *response)
+
+	if err != nil {
+		return playbooks, err
+	}
+
+	return playbooks, nil
+}
+
+// SyncProject starts a project update for a project by ID
+//
+//	:param id: The ID of the project to sync
+func (project *Project) SyncProject(id int32) (schemaResponse ProjectUpdateStartResponseSchema, err error) {
+	schemaResponse = ProjectUpdateStartResponseSchema{}
+
+	uri := fmt.Sprintf("%s%d/update/", project.URI, id)
+
+	response, err := project.connection.Post(uri, []byte("{}"))
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = project.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	if schemaResponse.ProjectUpdate == 0 {
+		schemaResponse.ProjectUpdate = schemaResponse.ID
+	}
+
+	return schemaResponse, nil
+}
+
+// GetProjectUpdate gets a project update by ID
+//
+//	:param id: The ID of the project update to get
+func (project *Project) GetProjectUpdate(id int32) (schemaResponse ProjectUpdateResponseSingleSchema, err error) {
+	schemaResponse = ProjectUpdateResponseSingleSchema{}
+
+	uri := fmt.Sprintf("%s%d/", project.ProjectUpdateURI, id)
+
+	response, err := project.connection.Get(uri, nil)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = project.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// GetProjectUpdateStatus gets the status of a project update by ID
+//
+//	:param id: The ID of the project update to get the status for
+func (project *Project) GetProjectUpdateStatus(id int32) (status string, err error) {
+	response, err := project.GetProjectUpdate(id)
+
+	if err != nil {
+		return "", err
+	}
+
+	if response.Status == "" {
+		return "", fmt.Errorf("status not found for project update %d", id)
+	}
+
+	return response.Status, nil
+}
+
+// GetProjectUpdateStdOut gets the standard output of a project update by ID
+//
+//	:param id: The ID of the project update to get the standard output for
+//	:param outputFormat: The format to get the output in ("txt", "ansi", "json", "html")
+func (project *Project) GetProjectUpdateStdOut(id int32, outputFormat string) (response string, err error) {
+	params := map[string]string{
+		"format": outputFormat,
+	}
+
+	uri := fmt.Sprintf("%s%d/stdout/", project.ProjectUpdateURI, id)
+
+	resp, err := project.connection.Get(uri, params)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(body), nil
+}
+
+// WaitForProjectUpdate polls a project update until it reaches a finished status
+//
+//	:param ctx: The context used to stop waiting
+//	:param id: The ID of the project update to wait for
+//	:param options: The poll options, the interval must be positive
+func (project *Project) WaitForProjectUpdate(ctx context.Context, id int32, options polling.Options) (status string, err error) {
+	watch := polling.Watch{Label: "Project Update", ID: id}
+
+	return polling.WaitForStatus(ctx, options, watch, func() (string, error) {
+		return project.GetProjectUpdateStatus(id)
+	})
+}
+
+// SyncAndWait syncs a project and waits for the resulting project update to finish
+//
+//	:param ctx: The context used to stop waiting
+//	:param id: The ID of the project to sync
+//	:param options: The poll options, the interval must be positive
+func (project *Project) SyncAndWait(ctx context.Context, id int32, options polling.Options) (schemaResponse ProjectUpdateResponseSingleSchema, err error) {
+	schemaResponse = ProjectUpdateResponseSingleSchema{}
+
+	syncResponse, err := project.SyncProject(id)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	_, err = project.WaitForProjectUpdate(ctx, syncResponse.ProjectUpdate, options)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return project.GetProjectUpdate(syncResponse.ProjectUpdate)
+}
@@ -0,0 +1,122 @@
+package projects
+
+// ProjectRequestSchema is the schema for a project request
+type ProjectRequestSchema struct {
+	Name                          string `json:"name" yaml:"name"`
+	Description                   string `json:"description" yaml:"description"`
+	Organization                  int32  `json:"organization" yaml:"organization"`
+	ScmType                       string `json:"scm_type" yaml:"scm_type"`
+	ScmURL                        string `json:"scm_url" yaml:"scm_url"`
+	ScmBranch                     string `json:"scm_branch" yaml:"scm_branch"`
+	ScmRefspec                    string `json:"scm_refspec" yaml:"scm_refspec"`
+	ScmClean                      bool   `json:"scm_clean" yaml:"scm_clean"`
+	ScmTrackSubmodules            bool   `json:"scm_track_submodules" yaml:"scm_track_submodules"`
+	ScmDeleteOnUpdate             bool   `json:"scm_delete_on_update" yaml:"scm_delete_on_update"`
+	Credential                    int32  `json:"credential,omitempty" yaml:"credential,omitempty"`
+	Timeout                       int32  `json:"timeout" yaml:"timeout"`
+	ScmUpdateOnLaunch             bool   `json:"scm_update_on_launch" yaml:"scm_update_on_launch"`
+	ScmUpdateCacheTimeout         int32  `json:"scm_update_cache_timeout" yaml:"scm_update_cache_timeout"`
+	AllowOverride                 bool   `json:"allow_override" yaml:"allow_override"`
+	DefaultEnvironment            int32  `json:"default_environment,omitempty" yaml:"default_environment,omitempty"`
+	SignatureValidationCredential int32  `json:"signature_validation_credential,omitempty" yaml:"signature_validation_credential,omitempty"`
+}
+
+// ProjectRelatedResponseSchema is the schema for the related section of a response
+type ProjectRelatedResponseSchema struct {
+	CreatedBy                    string `json:"created_by" yaml:"created_by"`
+	ModifiedBy                   string `json:"modified_by" yaml:"modified_by"`
+	Teams                        string `json:"teams" yaml:"teams"`
+	Playbooks                    string `json:"playbooks" yaml:"playbooks"`
+	InventoryFiles               string `json:"inventory_files" yaml:"inventory_files"`
+	Update                       string `json:"update" yaml:"update"`
+	ProjectUpdates               string `json:"project_updates" yaml:"project_updates"`
+	ScmInventorySources          string `json:"scm_inventory_sources" yaml:"scm_inventory_sources"`
+	Schedules                    string `json:"schedules" yaml:"schedules"`
+	ActivityStream               string `json:"activity_stream" yaml:"activity_stream"`
+	NotificationTemplatesStarted string `json:"notification_templates_started" yaml:"notification_templates_started"`
+	NotificationTemplatesSuccess string `json:"notification_templates_success" yaml:"notification_templates_success"`
+	NotificationTemplatesError   string `json:"notification_templates_error" yaml:"notification_templates_error"`
+	AccessList                   string `json:"access_list" yaml:"access_list"`
+	ObjectRoles                  string `json:"object_roles" yaml:"object_roles"`
+	Copy                         string `json:"copy" yaml:"copy"`
+	Organization                 string `json:"organization" yaml:"organization"`
+	Credential                   string `json:"credential" yaml:"credential"`
+	LastJob                      string `json:"last_job" yaml:"last_job"`
+	LastUpdate                   string `json:"last_update" yaml:"last_update"`
+}
+
+// ProjectResponseSingleSchema is the schema for a single project response item
+type ProjectResponseSingleSchema struct {
+	ID      int32                        `json:"id" yaml:"id"`
+	Type    string                       `json:"type" yaml:"type"`
+	URL     string                       `json:"url" yaml:"url"`
+	Related ProjectRelatedResponseSchema `json:"related" yaml:"related"`
+	ProjectRequestSchema
+	Created          string `json:"created" yaml:"created"`
+	Modified         string `json:"modified" yaml:"modified"`
+	LocalPath        string `json:"local_path" yaml:"local_path"`
+	ScmRevision      string `json:"scm_revision" yaml:"scm_revision"`
+	LastJobRun       string `json:"last_job_run" yaml:"last_job_run"`
+	LastJobFailed    bool   `json:"last_job_failed" yaml:"last_job_failed"`
+	NextJobRun       string `json:"next_job_run" yaml:"next_job_run"`
+	Status           string `json:"status" yaml:"status"`
+	LastUpdateFailed bool   `json:"last_update_failed" yaml:"last_update_failed"`
+	LastUpdated      string `json:"last_updated" yaml:"last_updated"`
+}
+
+// ProjectResponseSchema is the schema for a project response
+type ProjectResponseSchema struct {
+	Count    int32                         `json:"count" yaml:"count"`
+	Next     string                        `json:"next" yaml:"next"`
+	Previous string                        `json:"previous" yaml:"previous"`
+	Results  []ProjectResponseSingleSchema `json:"results" yaml:"results"`
+}
+
+// ProjectUpdateStartResponseSchema is the schema for the response of starting a project update
+type ProjectUpdateStartResponseSchema struct {
+	ProjectUpdate int32  `json:"project_update" yaml:"project_update"`
+	ID            int32  `json:"id" yaml:"id"`
+	Type          string `json:"type" yaml:"type"`
+	URL           string `json:"url" yaml:"url"`
+	Status        string `json:"status" yaml:"status"`
+}
+
+// ProjectUpdateRelatedResponseSchema is the schema for the related section of a project update response
+type ProjectUpdateRelatedResponseSchema struct {
+	CreatedBy           string `json:"created_by" yaml:"created_by"`
+	UnifiedJobTemplate  string `json:"unified_job_template" yaml:"unified_job_template"`
+	StdOut              string `json:"stdout" yaml:"stdout"`
+	Project             string `json:"project" yaml:"project"`
+	Credential          string `json:"credential" yaml:"credential"`
+	Cancel              string `json:"cancel" yaml:"cancel"`
+	Notifications       string `json:"notifications" yaml:"notifications"`
+	Events              string `json:"events" yaml:"events"`
+	ScmInventoryUpdates string `json:"scm_inventory_updates" yaml:"scm_inventory_updates"`
+}
+
+// ProjectUpdateResponseSingleSchema is the schema for a single project update response item
+type ProjectUpdateResponseSingleSchema struct {
+	ID                 int32                              `json:"id" yaml:"id"`
+	Type               string                             `json:"type" yaml:"type"`
+	URL                string                             `json:"url" yaml:"url"`
+	Related            ProjectUpdateRelatedResponseSchema `json:"related" yaml:"related"`
+	Created            string                             `json:"created" yaml:"created"`
+	Modified           string                             `json:"modified" yaml:"modified"`
+	Name               string                             `json:"name" yaml:"name"`
+	Description        string                             `json:"description" yaml:"description"`
+	UnifiedJobTemplate int32                              `json:"unified_job_template" yaml:"unified_job_template"`
+	LaunchType         string                             `json:"launch_type" yaml:"launch_type"`
+	Status             string                             `json:"status" yaml:"status"`
+	Failed             bool                               `json:"failed" yaml:"failed"`
+	Started            string                             `json:"started" yaml:"started"`
+	Finished           string                             `json:"finished" yaml:"finished"`
+	Elapsed            float32                            `json:"elapsed" yaml:"elapsed"`
+	JobExplanation     string                             `json:"job_explanation" yaml:"job_explanation"`
+	ExecutionNode      string                             `json:"execution_node" yaml:"execution_node"`
+	Project            int32                              `json:"project" yaml:"project"`
+	ScmType            string                             `json:"scm_type" yaml:"scm_type"`
+	ScmURL             string                             `json:"scm_url" yaml:"scm_url"`
+	ScmBranch          string                             `json:"scm_branch" yaml:"scm_branch"`
+	ScmRevision        string                             `json:"scm_revision" yaml:"scm_revision"`
+	JobType            string                             `json:"job_type" yaml:"job_type"`
+}
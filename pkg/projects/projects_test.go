@@ -0,0 +1,147 @@
+package projects
+
+import (
+	"context"
+	"errors"
+	"github.com/btr1975/go-ansible-aap-api-client/internal/fakeconnection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/polling"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// newFakeProjectConnection answers the patch and sync of project 5 and project update 60 with a sequence of
+// statuses, one per poll
+func newFakeProjectConnection(syncResponse map[string]any, statuses ...string) *fakeconnection.Connection {
+	polls := 0
+
+	connection := fakeconnection.New().
+		HandleJSON(http.MethodPatch, "projects/5/", ProjectResponseSingleSchema{ID: 5, ProjectRequestSchema: ProjectRequestSchema{Name: "Network Playbooks", ScmBranch: "main"}}).
+		HandleJSON(http.MethodPost, "projects/5/update/", syncResponse)
+
+	connection.Handle(http.MethodGet, "project_updates/60/", func(fakeconnection.Request) (*http.Response, error) {
+		status := statuses[min(polls, len(statuses)-1)]
+		polls++
+
+		if status == "reset" {
+			return nil, errors.New("connection reset by peer")
+		}
+
+		return fakeconnection.JSON(ProjectUpdateResponseSingleSchema{ID: 60, Status: status})
+	})
+
+	return connection
+}
+
+func TestProject_UpdateProject(t *testing.T) {
+	connection := newFakeProjectConnection(nil, "pending")
+
+	response, err := NewProject(connection).UpdateProject(5, ProjectRequestSchema{Name: "Network Playbooks", ScmBranch: "main"})
+
+	if err != nil || response.ID != 5 || response.ScmBranch != "main" {
+		t.Fatalf("Project.UpdateProject() = %+v, err = %v", response, err)
+	}
+
+	data := map[string]any{}
+	_ = connection.Requests()[0].Decode(&data)
+
+	if data["scm_branch"] != "main" {
+		t.Errorf("Project.UpdateProject() sent %v, want scm_branch main", data)
+	}
+
+	for _, field := range []string{"credential", "default_environment", "signature_validation_credential"} {
+		if _, ok := data[field]; ok {
+			t.Errorf("Project.UpdateProject() sent %s = %v, want it left out", field, data[field])
+		}
+	}
+}
+
+func TestProject_SyncProject(t *testing.T) {
+	tests := []struct {
+		name         string
+		syncResponse map[string]any
+	}{
+		{
+			name:         "Test SyncProject project update",
+			syncResponse: map[string]any{"project_update": 60, "id": 60, "status": "pending"},
+		},
+		{
+			name:         "Test SyncProject falls back to the ID",
+			syncResponse: map[string]any{"id": 60, "status": "pending"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, err := NewProject(newFakeProjectConnection(tt.syncResponse, "pending")).SyncProject(5)
+
+			if err != nil || response.ProjectUpdate != 60 {
+				t.Errorf("Project.SyncProject() = %+v, err = %v, want project update 60", response, err)
+			}
+		})
+	}
+}
+
+func TestProject_WaitForProjectUpdate(t *testing.T) {
+	tests := []struct {
+		name       string
+		statuses   []string
+		wantStatus string
+		wantErr    bool
+		wantIs     error
+	}{
+		{
+			name:       "Test WaitForProjectUpdate successful",
+			statuses:   []string{"pending", "running", "successful"},
+			wantStatus: "successful",
+		},
+		{
+			name:       "Test WaitForProjectUpdate connection error",
+			statuses:   []string{"running", "reset"},
+			wantStatus: "running",
+			wantErr:    true,
+		},
+		{
+			name:       "Test WaitForProjectUpdate missing status",
+			statuses:   []string{""},
+			wantStatus: "new",
+			wantErr:    true,
+		},
+		{
+			name:       "Test WaitForProjectUpdate timeout",
+			statuses:   []string{"waiting"},
+			wantStatus: "waiting",
+			wantErr:    true,
+			wantIs:     polling.ErrTimeout,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			project := NewProject(newFakeProjectConnection(nil, tt.statuses...))
+
+			status, err := project.WaitForProjectUpdate(context.Background(), 60, polling.Options{Interval: time.Millisecond, MaxWait: 20 * time.Millisecond})
+
+			if status != tt.wantStatus || (err != nil) != tt.wantErr || (tt.wantIs != nil && !errors.Is(err, tt.wantIs)) {
+				t.Errorf("Project.WaitForProjectUpdate() = %s, %v, want %s and error %v", status, err, tt.wantStatus, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestProject_SyncAndWait(t *testing.T) {
+	project := NewProject(newFakeProjectConnection(map[string]any{"project_update": 60}, "running", "successful"))
+
+	response, err := project.SyncAndWait(context.Background(), 5, polling.Options{Interval: time.Millisecond})
+
+	if err != nil || response.ID != 60 || response.Status != "successful" {
+		t.Errorf("Project.SyncAndWait() = %+v, err = %v, want project update 60 successful", response, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	project = NewProject(newFakeProjectConnection(map[string]any{"project_update": 60}, "running"))
+
+	if _, err = project.SyncAndWait(ctx, 5, polling.Options{Interval: time.Millisecond}); !errors.Is(err, context.Canceled) {
+		t.Errorf("Project.SyncAndWait() error = %v, want context canceled", err)
+	}
+}
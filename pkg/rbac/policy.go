@@ -0,0 +1,540 @@
+package rbac
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/common"
+	"gopkg.in/yaml.v3"
+	"slices"
+)
+
+// Actions of a policy change
+const (
+	PolicyActionGrant  = "grant"
+	PolicyActionRevoke = "revoke"
+)
+
+// Subject types of a policy change
+const (
+	PolicySubjectUser = "user"
+	PolicySubjectTeam = "team"
+)
+
+// PolicyResourceTypes maps the resource types of a policy to their resource URI
+var PolicyResourceTypes = map[string]string{
+	"job_template":          ResourceJobTemplate,
+	"workflow_job_template": ResourceWorkflowJobTemplate,
+	"inventory":             ResourceInventory,
+	"project":               ResourceProject,
+	"credential":            ResourceCredential,
+	"organization":          ResourceOrganization,
+	"team":                  ResourceTeam,
+}
+
+// policyRoles are the roles a policy can grant
+var policyRoles = []string{RoleAdmin, RoleUse, RoleExecute, RoleRead, RoleUpdate, RoleAdhoc, RoleMember, RoleApprove}
+
+// policyResource is a resource named by a policy
+type policyResource struct {
+	ResourceType         string
+	Resource             string
+	ResourceOrganization string
+}
+
+// policySubject is a user or team named by a policy
+type policySubject struct {
+	SubjectType      string
+	Subject          string
+	TeamOrganization string
+}
+
+// policyRole is a role of a resource found by its ID
+type policyRole struct {
+	ResourceType string
+	ResourceID   int32
+	Role         string
+}
+
+// policyAssignment is a role held by a user or team, by their IDs so the same role and subject named in different
+// ways is one assignment
+type policyAssignment struct {
+	SubjectType string
+	SubjectID   int32
+	RoleID      int32
+}
+
+// policyStep is a grant or revoke of a policy reconcile and the assignment it changes
+type policyStep struct {
+	change     PolicyChangeSchema
+	assignment policyAssignment
+}
+
+// policyState is the current state of the resources named by a policy and the IDs needed to change it
+type policyState struct {
+	assignments  []policyAssignment
+	descriptions map[policyAssignment]PolicyChangeSchema
+	resourceIDs  map[policyResource]int32
+	roleIDs      map[policyRole]int32
+	subjectIDs   map[policySubject]int32
+}
+
+// LoadPolicy loads and validates a policy from YAML, or JSON as it is a subset of YAML
+//
+//	:param data: The YAML of the policy
+func LoadPolicy(data []byte) (policy PolicySchema, err error) {
+	policy = PolicySchema{}
+
+	err = yaml.Unmarshal(data, &policy)
+
+	if err != nil {
+		return policy, err
+	}
+
+	return policy, policy.Validate()
+}
+
+// Validate validates a policy and returns every problem found
+func (policy PolicySchema) Validate() error {
+	errs := []error{}
+
+	for _, role := range policy.ManagedRoles {
+		if !slices.Contains(policyRoles, role) {
+			errs = append(errs, fmt.Errorf("managed role %q is not one of %v", role, policyRoles))
+		}
+	}
+
+	if len(policy.ManagedResources) > 0 && len(policy.ManagedRoles) == 0 {
+		errs = append(errs, errors.New("managed roles are required with managed resources"))
+	}
+
+	for i, resource := range policy.ManagedResources {
+		if _, ok := PolicyResourceTypes[resource.ResourceType]; !ok {
+			errs = append(errs, fmt.Errorf("managed resource %d: unknown resource type %q", i, resource.ResourceType))
+		}
+
+		if resource.Resource == "" {
+			errs = append(errs, fmt.Errorf("managed resource %d: resource is required", i))
+		}
+	}
+
+	for i, grant := range policy.Grants {
+		if (grant.User == "") == (grant.Team == "") {
+			errs = append(errs, fmt.Errorf("grant %d: exactly one of user or team is required", i))
+		}
+
+		if grant.TeamOrganization != "" && grant.Team == "" {
+			errs = append(errs, fmt.Errorf("grant %d: team organization is only valid with a team", i))
+		}
+
+		if !slices.Contains(policyRoles, grant.Role) {
+			errs = append(errs, fmt.Errorf("grant %d: role %q is not one of %v", i, grant.Role, policyRoles))
+		} else if len(policy.ManagedRoles) > 0 && !slices.Contains(policy.ManagedRoles, grant.Role) {
+			errs = append(errs, fmt.Errorf("grant %d: role %q is not a managed role", i, grant.Role))
+		}
+
+		if _, ok := PolicyResourceTypes[grant.ResourceType]; !ok {
+			errs = append(errs, fmt.Errorf("grant %d: unknown resource type %q", i, grant.ResourceType))
+		}
+
+		if grant.Resource == "" {
+			errs = append(errs, fmt.Errorf("grant %d: resource is required", i))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// managedRoles gets the roles a policy owns, the roles of its grants when none are set
+func (policy PolicySchema) managedRoles() []string {
+	roles := slices.Clone(policy.ManagedRoles)
+
+	if len(roles) == 0 {
+		for _, grant := range policy.Grants {
+			roles = append(roles, grant.Role)
+		}
+	}
+
+	slices.Sort(roles)
+
+	return slices.Compact(roles)
+}
+
+// resources gets the resources a policy owns, its managed resources followed by the resources of its grants
+//
+//	:param desired: The assignments of the grants of the policy
+func (policy PolicySchema) resources(desired []PolicyChangeSchema) []policyResource {
+	resources := []policyResource{}
+
+	for _, resource := range policy.ManagedResources {
+		if !slices.Contains(resources, policyResource(resource)) {
+			resources = append(resources, policyResource(resource))
+		}
+	}
+
+	for _, assignment := range desired {
+		if !slices.Contains(resources, assignment.resource()) {
+			resources = append(resources, assignment.resource())
+		}
+	}
+
+	return resources
+}
+
+// assignments converts the grants of a policy to the assignments AAP should have
+func (policy PolicySchema) assignments() []PolicyChangeSchema {
+	assignments := []PolicyChangeSchema{}
+
+	for _, grant := range policy.Grants {
+		assignment := PolicyChangeSchema{
+			SubjectType:          PolicySubjectUser,
+			Subject:              grant.User,
+			Role:                 grant.Role,
+			ResourceType:         grant.ResourceType,
+			Resource:             grant.Resource,
+			ResourceOrganization: grant.ResourceOrganization,
+		}
+
+		if grant.Team != "" {
+			assignment.SubjectType = PolicySubjectTeam
+			assignment.Subject = grant.Team
+			assignment.TeamOrganization = grant.TeamOrganization
+		}
+
+		assignments = append(assignments, assignment)
+	}
+
+	return assignments
+}
+
+// resource gets the resource of a policy change
+func (change PolicyChangeSchema) resource() policyResource {
+	return policyResource{ResourceType: change.ResourceType, Resource: change.Resource, ResourceOrganization: change.ResourceOrganization}
+}
+
+// subject gets the subject of a policy change
+func (change PolicyChangeSchema) subject() policySubject {
+	return policySubject{SubjectType: change.SubjectType, Subject: change.Subject, TeamOrganization: change.TeamOrganization}
+}
+
+// String describes a policy change, for example "grant execute on job_template Deploy to team Campus"
+func (change PolicyChangeSchema) String() string {
+	preposition := "to"
+
+	if change.Action == PolicyActionRevoke {
+		preposition = "from"
+	}
+
+	return fmt.Sprintf("%s %s on %s %s %s %s %s", change.Action, change.Role, change.ResourceType, change.Resource, preposition, change.SubjectType, change.Subject)
+}
+
+// Drift checks if AAP did not match the policy when the report was made
+func (report PolicyReportSchema) Drift() bool {
+	return len(report.Changes) > 0
+}
+
+// planPolicy computes the grants and revokes that turn the current assignments into the desired ones
+//
+// Grants come before revokes so a subject does not lose access while its roles are moved.
+//
+//	:param desired: The assignments the policy wants
+//	:param current: The assignments AAP has on the managed roles of the resources of the policy
+//	:param descriptions: The descriptions of the desired and current assignments
+func planPolicy(desired []policyAssignment, current []policyAssignment, descriptions map[policyAssignment]PolicyChangeSchema) []policyStep {
+	steps := []policyStep{}
+
+	for _, assignment := range desired {
+		if !slices.Contains(current, assignment) {
+			steps = append(steps, policyStep{change: descriptions[assignment].withAction(PolicyActionGrant), assignment: assignment})
+		}
+	}
+
+	for _, assignment := range current {
+		if !slices.Contains(desired, assignment) {
+			steps = append(steps, policyStep{change: descriptions[assignment].withAction(PolicyActionRevoke), assignment: assignment})
+		}
+	}
+
+	slices.SortFunc(steps, func(a policyStep, b policyStep) int {
+		return cmp.Or(
+			cmp.Compare(a.change.Action, b.change.Action),
+			cmp.Compare(a.change.ResourceType, b.change.ResourceType),
+			cmp.Compare(a.change.Resource, b.change.Resource),
+			cmp.Compare(a.change.Role, b.change.Role),
+			cmp.Compare(a.change.SubjectType, b.change.SubjectType),
+			cmp.Compare(a.change.Subject, b.change.Subject),
+			cmp.Compare(a.assignment.RoleID, b.assignment.RoleID),
+			cmp.Compare(a.assignment.SubjectID, b.assignment.SubjectID),
+		)
+	})
+
+	return steps
+}
+
+// withAction gets a copy of an assignment with an action
+//
+//	:param action: The action, PolicyActionGrant or PolicyActionRevoke
+func (change PolicyChangeSchema) withAction(action string) PolicyChangeSchema {
+	change.Action = action
+
+	return change
+}
+
+// ReconcilePolicy makes the roles of AAP match a policy and reports the grants and revokes needed
+//
+// Only the managed roles of the managed resources and the resources of the grants are changed, every other role is
+// left alone. Resources, users and teams are compared by ID, so a resource named with and without its organization
+// is one resource. A dry run only reports the changes, a report with changes after a dry run means AAP drifted from
+// the policy.
+//
+//	:param policy: The policy to reconcile
+//	:param dryRun: Whether to only report the changes instead of making them
+func (rbac *RBAC) ReconcilePolicy(policy PolicySchema, dryRun bool) (report PolicyReportSchema, err error) {
+	report = PolicyReportSchema{Changes: []PolicyChangeSchema{}, Applied: []PolicyChangeSchema{}, DryRun: dryRun}
+
+	err = policy.Validate()
+
+	if err != nil {
+		return report, err
+	}
+
+	grants := policy.assignments()
+
+	state, err := rbac.getPolicyState(policy.resources(grants), policy.managedRoles())
+
+	if err != nil {
+		return report, err
+	}
+
+	desired, err := rbac.resolveAssignments(&state, grants)
+
+	if err != nil {
+		return report, err
+	}
+
+	steps := planPolicy(desired, state.assignments, state.descriptions)
+
+	for _, step := range steps {
+		report.Changes = append(report.Changes, step.change)
+	}
+
+	if dryRun {
+		return report, nil
+	}
+
+	for _, step := range steps {
+		uri := fmt.Sprintf("%s%d/roles/", rbac.UserURI, step.assignment.SubjectID)
+
+		if step.assignment.SubjectType == PolicySubjectTeam {
+			uri = fmt.Sprintf("%s%d/roles/", rbac.TeamURI, step.assignment.SubjectID)
+		}
+
+		_, err = common.Associate(rbac.connection, uri, step.assignment.RoleID, step.change.Action == PolicyActionRevoke)
+
+		if err != nil {
+			return report, fmt.Errorf("error applying %s: %w", step.change, err)
+		}
+
+		report.Applied = append(report.Applied, step.change)
+	}
+
+	return report, nil
+}
+
+// getPolicyState gets the current assignments of the managed roles of the resources a policy owns and the IDs of the
+// resources and roles involved
+//
+//	:param resources: The resources the policy owns
+//	:param managedRoles: The roles the policy owns
+func (rbac *RBAC) getPolicyState(resources []policyResource, managedRoles []string) (state policyState, err error) {
+	state = policyState{
+		assignments:  []policyAssignment{},
+		descriptions: map[policyAssignment]PolicyChangeSchema{},
+		resourceIDs:  map[policyResource]int32{},
+		roleIDs:      map[policyRole]int32{},
+		subjectIDs:   map[policySubject]int32{},
+	}
+
+	// a resource named both with and without its organization has its roles loaded once
+	loaded := map[policyRole]bool{}
+
+	for _, resource := range resources {
+		resourceURI := PolicyResourceTypes[resource.ResourceType]
+
+		params := map[string]string{"name": resource.Resource}
+
+		if resource.ResourceOrganization != "" && resourceURI != ResourceOrganization {
+			params["organization__name"] = resource.ResourceOrganization
+		}
+
+		resourceID, err := rbac.findID(resourceURI, params, fmt.Sprintf("%s %s", resource.ResourceType, resource.Resource))
+
+		if err != nil {
+			return state, err
+		}
+
+		state.resourceIDs[resource] = resourceID
+
+		if loaded[policyRole{ResourceType: resource.ResourceType, ResourceID: resourceID}] {
+			continue
+		}
+
+		loaded[policyRole{ResourceType: resource.ResourceType, ResourceID: resourceID}] = true
+
+		roles, err := rbac.GetObjectRoles(resourceURI, resourceID)
+
+		if err != nil {
+			return state, err
+		}
+
+		for _, objectRole := range roles {
+			role := RoleName(objectRole.Name)
+
+			if !slices.Contains(managedRoles, role) {
+				continue
+			}
+
+			state.roleIDs[policyRole{ResourceType: resource.ResourceType, ResourceID: resourceID, Role: role}] = objectRole.ID
+
+			err = rbac.getRoleMembers(&state, resource, role, objectRole.ID)
+
+			if err != nil {
+				return state, err
+			}
+		}
+	}
+
+	return state, nil
+}
+
+// resolveAssignments converts the assignments of the grants of a policy to the IDs of their roles and subjects,
+// dropping the ones that name the same role and subject twice
+//
+//	:param state: The policy state with the resources of the grants
+//	:param grants: The assignments of the grants of the policy
+func (rbac *RBAC) resolveAssignments(state *policyState, grants []PolicyChangeSchema) (assignments []policyAssignment, err error) {
+	assignments = []policyAssignment{}
+
+	for _, grant := range grants {
+		roleID, ok := state.roleIDs[policyRole{ResourceType: grant.ResourceType, ResourceID: state.resourceIDs[grant.resource()], Role: grant.Role}]
+
+		if !ok {
+			return assignments, fmt.Errorf("no %s role found on %s %s", grant.Role, grant.ResourceType, grant.Resource)
+		}
+
+		subjectID, err := rbac.getSubjectID(state, grant.subject())
+
+		if err != nil {
+			return assignments, err
+		}
+
+		assignment := policyAssignment{SubjectType: grant.SubjectType, SubjectID: subjectID, RoleID: roleID}
+
+		if slices.Contains(assignments, assignment) {
+			continue
+		}
+
+		assignments = append(assignments, assignment)
+
+		if _, ok := state.descriptions[assignment]; !ok {
+			state.descriptions[assignment] = grant
+		}
+	}
+
+	return assignments, nil
+}
+
+// getSubjectID gets the ID of a user or team named by a policy, teams are found in their organization when it is set
+//
+//	:param state: The policy state caching the IDs found
+//	:param subject: The user or team
+func (rbac *RBAC) getSubjectID(state *policyState, subject policySubject) (id int32, err error) {
+	if id, ok := state.subjectIDs[subject]; ok {
+		return id, nil
+	}
+
+	uri, params := rbac.UserURI, map[string]string{"username": subject.Subject}
+
+	if subject.SubjectType == PolicySubjectTeam {
+		uri, params = rbac.TeamURI, map[string]string{"name": subject.Subject}
+
+		if subject.TeamOrganization != "" {
+			params["organization__name"] = subject.TeamOrganization
+		}
+	}
+
+	id, err = rbac.findID(uri, params, fmt.Sprintf("%s %s", subject.SubjectType, subject.Subject))
+
+	if err != nil {
+		return 0, err
+	}
+
+	state.subjectIDs[subject] = id
+
+	return id, nil
+}
+
+// getRoleMembers adds the users and teams that hold a role of a resource directly to a policy state
+//
+//	:param state: The policy state to add to
+//	:param resource: The resource of the role
+//	:param role: The role
+//	:param roleID: The ID of the object role
+func (rbac *RBAC) getRoleMembers(state *policyState, resource policyResource, role string, roleID int32) (err error) {
+	for _, subjectType := range []string{PolicySubjectUser, PolicySubjectTeam} {
+		members, err := common.GetAllPages[namedSchema](rbac.connection, rbac.DataConversion, fmt.Sprintf("%s%d/%ss/", rbac.RoleURI, roleID, subjectType), nil)
+
+		if err != nil {
+			return err
+		}
+
+		for _, member := range members {
+			assignment := policyAssignment{SubjectType: subjectType, SubjectID: member.ID, RoleID: roleID}
+
+			if slices.Contains(state.assignments, assignment) {
+				continue
+			}
+
+			description := PolicyChangeSchema{
+				SubjectType:          subjectType,
+				Subject:              member.Name,
+				Role:                 role,
+				ResourceType:         resource.ResourceType,
+				Resource:             resource.Resource,
+				ResourceOrganization: resource.ResourceOrganization,
+			}
+
+			if subjectType == PolicySubjectUser {
+				description.Subject = member.Username
+			}
+
+			state.assignments = append(state.assignments, assignment)
+			state.descriptions[assignment] = description
+		}
+	}
+
+	return nil
+}
+
+// findID finds the ID of the one object of a list endpoint that matches the params
+//
+//	:param uri: The URI of the list endpoint
+//	:param params: The query params to filter with
+//	:param description: The description of the object for errors, for example "team Campus"
+func (rbac *RBAC) findID(uri string, params map[string]string, description string) (id int32, err error) {
+	found, err := common.GetAllPages[namedSchema](rbac.connection, rbac.DataConversion, uri, params)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if len(found) == 0 {
+		return 0, fmt.Errorf("no %s found", description)
+	}
+
+	if len(found) > 1 {
+		return 0, fmt.Errorf("%d objects found for %s, set its organization", len(found), description)
+	}
+
+	return found[0].ID, nil
+}
@@ -0,0 +1,83 @@
+package rbac
+
+// PolicySchema is the schema for a declarative policy of who has which role on which resource
+//
+// Example YAML:
+//
+//	managed_roles: [execute, admin]
+//	managed_resources:
+//	  - resource_type: job_template
+//	    resource: Retired Config
+//	grants:
+//	  - team: Campus
+//	    role: execute
+//	    resource_type: job_template
+//	    resource: Deploy Config
+//	  - user: alice
+//	    role: admin
+//	    resource_type: inventory
+//	    resource: Core Routers
+//	    resource_organization: Network Engineering
+//	  - team: Operators
+//	    team_organization: Network Engineering
+//	    role: read
+//	    resource_type: inventory
+//	    resource: Core Routers
+//	    resource_organization: Network Engineering
+type PolicySchema struct {
+	// ManagedRoles are the roles the policy owns on every resource it names, defaults to every role of the grants
+	ManagedRoles []string `json:"managed_roles,omitempty" yaml:"managed_roles,omitempty"`
+	// ManagedResources are resources the policy owns even when no grant names them, so removing the last grant of a
+	// resource revokes its managed roles, they need the managed roles to be set
+	ManagedResources []PolicyResourceSchema `json:"managed_resources,omitempty" yaml:"managed_resources,omitempty"`
+	Grants           []PolicyGrantSchema    `json:"grants" yaml:"grants"`
+}
+
+// PolicyResourceSchema is the schema for a resource managed by a policy
+type PolicyResourceSchema struct {
+	ResourceType string `json:"resource_type" yaml:"resource_type"`
+	Resource     string `json:"resource" yaml:"resource"`
+	// ResourceOrganization is the name of the organization of the resource, needed when names are not unique
+	ResourceOrganization string `json:"resource_organization,omitempty" yaml:"resource_organization,omitempty"`
+}
+
+// PolicyGrantSchema is the schema for a grant of a role on a resource to a user or a team
+type PolicyGrantSchema struct {
+	User string `json:"user,omitempty" yaml:"user,omitempty"`
+	Team string `json:"team,omitempty" yaml:"team,omitempty"`
+	// TeamOrganization is the name of the organization of the team, needed when team names are not unique
+	TeamOrganization string `json:"team_organization,omitempty" yaml:"team_organization,omitempty"`
+	Role             string `json:"role" yaml:"role"`
+	ResourceType     string `json:"resource_type" yaml:"resource_type"`
+	Resource         string `json:"resource" yaml:"resource"`
+	// ResourceOrganization is the name of the organization of the resource, needed when names are not unique
+	ResourceOrganization string `json:"resource_organization,omitempty" yaml:"resource_organization,omitempty"`
+}
+
+// PolicyChangeSchema is the schema for a grant or revoke needed to make AAP match a policy
+type PolicyChangeSchema struct {
+	Action               string `json:"action" yaml:"action"`
+	SubjectType          string `json:"subject_type" yaml:"subject_type"`
+	Subject              string `json:"subject" yaml:"subject"`
+	TeamOrganization     string `json:"team_organization,omitempty" yaml:"team_organization,omitempty"`
+	Role                 string `json:"role" yaml:"role"`
+	ResourceType         string `json:"resource_type" yaml:"resource_type"`
+	Resource             string `json:"resource" yaml:"resource"`
+	ResourceOrganization string `json:"resource_organization,omitempty" yaml:"resource_organization,omitempty"`
+}
+
+// PolicyReportSchema is the schema for the report of a policy reconcile
+type PolicyReportSchema struct {
+	// Changes are the grants and revokes needed to make AAP match the policy
+	Changes []PolicyChangeSchema `json:"changes" yaml:"changes"`
+	// Applied are the changes that were made, empty for a dry run
+	Applied []PolicyChangeSchema `json:"applied" yaml:"applied"`
+	DryRun  bool                 `json:"dry_run" yaml:"dry_run"`
+}
+
+// namedSchema is the schema for the fields used to find a user, team or resource by name
+type namedSchema struct {
+	ID       int32  `json:"id" yaml:"id"`
+	Name     string `json:"name" yaml:"name"`
+	Username string `json:"username" yaml:"username"`
+}
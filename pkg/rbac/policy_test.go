@@ -0,0 +1,268 @@
+package rbac
+
+import (
+	"github.com/btr1975/go-ansible-aap-api-client/internal/fakeconnection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/common"
+	"maps"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+// newFakePolicyConnection answers with job template 7 "Deploy", its roles and members, and accepts role associations
+func newFakePolicyConnection() *fakeconnection.Connection {
+	page := func(results ...namedSchema) common.PageSchema[namedSchema] {
+		return common.PageSchema[namedSchema]{Count: int32(len(results)), Results: results}
+	}
+
+	connection := fakeconnection.New().
+		HandleJSON(http.MethodGet, "job_templates/", page(namedSchema{ID: 7, Name: "Deploy"})).
+		HandleJSON(http.MethodGet, "job_templates/7/object_roles/", ObjectRoleResponseSchema{Count: 3, Results: []ObjectRoleSchema{{ID: 70, Name: "Admin"}, {ID: 71, Name: "Execute"}, {ID: 72, Name: "Read"}}}).
+		HandleJSON(http.MethodGet, "roles/70/users/", page(namedSchema{ID: 2, Username: "owner"})).
+		HandleJSON(http.MethodGet, "roles/71/users/", page(namedSchema{ID: 3, Username: "operator"})).
+		HandleJSON(http.MethodGet, "roles/71/teams/", page(namedSchema{ID: 5, Name: "Campus"})).
+		HandleJSON(http.MethodGet, "roles/72/teams/", page(namedSchema{ID: 5, Name: "Campus"})).
+		HandleJSON(http.MethodGet, "roles/", page())
+
+	connection.Handle(http.MethodGet, "users/", func(request fakeconnection.Request) (*http.Response, error) {
+		ids := map[string]int32{"alice": 1, "owner": 2, "operator": 3}
+
+		if id, ok := ids[request.Params["username"]]; ok {
+			return fakeconnection.JSON(page(namedSchema{ID: id, Username: request.Params["username"]}))
+		}
+
+		return fakeconnection.JSON(page())
+	})
+
+	// there is a team Campus in the Network Engineering and the Security organizations
+	connection.Handle(http.MethodGet, "teams/", func(request fakeconnection.Request) (*http.Response, error) {
+		ids := map[string]int32{"": 5, "Network Engineering": 5, "Security": 6}
+
+		if id, ok := ids[request.Params["organization__name"]]; ok && request.Params["name"] == "Campus" {
+			return fakeconnection.JSON(page(namedSchema{ID: id, Name: "Campus"}))
+		}
+
+		return fakeconnection.JSON(page())
+	})
+
+	connection.HandlePrefix(http.MethodPost, "", func(fakeconnection.Request) (*http.Response, error) {
+		return fakeconnection.NoContent()
+	})
+
+	return connection
+}
+
+const testPolicy = `
+managed_roles: [admin, execute]
+grants:
+  - team: Campus
+    role: execute
+    resource_type: job_template
+    resource: Deploy
+  - user: alice
+    role: execute
+    resource_type: job_template
+    resource: Deploy
+  - user: owner
+    role: admin
+    resource_type: job_template
+    resource: Deploy
+`
+
+func TestLoadPolicy(t *testing.T) {
+	if _, err := LoadPolicy([]byte(testPolicy)); err != nil {
+		t.Fatalf("LoadPolicy() error = %v", err)
+	}
+
+	invalid := `
+managed_roles: [execute]
+grants:
+  - user: alice
+    team: Campus
+    role: admin
+    resource_type: playbook
+`
+	_, err := LoadPolicy([]byte(invalid))
+
+	if err == nil {
+		t.Fatalf("LoadPolicy() error = nil, want validation errors")
+	}
+
+	if got := len(err.(interface{ Unwrap() []error }).Unwrap()); got != 4 {
+		t.Errorf("LoadPolicy() errors = %d, want 4: %v", got, err)
+	}
+
+	unmanaged := `
+managed_resources:
+  - resource_type: playbook
+grants: []
+`
+	_, err = LoadPolicy([]byte(unmanaged))
+
+	if err == nil {
+		t.Fatalf("LoadPolicy() error = nil, want managed resource errors")
+	}
+
+	if got := len(err.(interface{ Unwrap() []error }).Unwrap()); got != 3 {
+		t.Errorf("LoadPolicy() errors = %d, want 3: %v", got, err)
+	}
+}
+
+func TestRBAC_ReconcilePolicy(t *testing.T) {
+	policy, err := LoadPolicy([]byte(testPolicy))
+
+	if err != nil {
+		t.Fatalf("LoadPolicy() error = %v", err)
+	}
+
+	connection := newFakePolicyConnection()
+	rbac := NewRBAC(connection)
+
+	want := []string{
+		"grant execute on job_template Deploy to user alice",
+		"revoke execute on job_template Deploy from user operator",
+	}
+
+	for _, dryRun := range []bool{true, false} {
+		report, err := rbac.ReconcilePolicy(policy, dryRun)
+
+		if err != nil {
+			t.Fatalf("RBAC.ReconcilePolicy(dryRun %t) error = %v", dryRun, err)
+		}
+
+		got := []string{}
+
+		for _, change := range report.Changes {
+			got = append(got, change.String())
+		}
+
+		if !report.Drift() || !slices.Equal(got, want) {
+			t.Errorf("RBAC.ReconcilePolicy(dryRun %t) changes = %v, want %v", dryRun, got, want)
+		}
+
+		if dryRun && (len(report.Applied) != 0 || len(associations(connection)) != 0) {
+			t.Errorf("RBAC.ReconcilePolicy(dryRun true) applied = %v, posts = %v, want none", report.Applied, associations(connection))
+		}
+	}
+
+	wantPosts := map[string]common.AssociationRequestSchema{
+		"users/1/roles/": {ID: 71},
+		"users/3/roles/": {ID: 71, Disassociate: true},
+	}
+
+	if posts := associations(connection); !maps.Equal(posts, wantPosts) {
+		t.Errorf("RBAC.ReconcilePolicy() posts = %v, want %v", posts, wantPosts)
+	}
+}
+
+func TestRBAC_ReconcilePolicy_managedResources(t *testing.T) {
+	policy, err := LoadPolicy([]byte(`
+managed_roles: [execute]
+managed_resources:
+  - resource_type: job_template
+    resource: Deploy
+grants: []
+`))
+
+	if err != nil {
+		t.Fatalf("LoadPolicy() error = %v", err)
+	}
+
+	connection := newFakePolicyConnection()
+	rbac := NewRBAC(connection)
+
+	report, err := rbac.ReconcilePolicy(policy, false)
+
+	if err != nil {
+		t.Fatalf("RBAC.ReconcilePolicy() error = %v", err)
+	}
+
+	got := []string{}
+
+	for _, change := range report.Applied {
+		got = append(got, change.String())
+	}
+
+	want := []string{
+		"revoke execute on job_template Deploy from team Campus",
+		"revoke execute on job_template Deploy from user operator",
+	}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("RBAC.ReconcilePolicy() applied = %v, want %v", got, want)
+	}
+
+	wantPosts := map[string]common.AssociationRequestSchema{
+		"teams/5/roles/": {ID: 71, Disassociate: true},
+		"users/3/roles/": {ID: 71, Disassociate: true},
+	}
+
+	if posts := associations(connection); !maps.Equal(posts, wantPosts) {
+		t.Errorf("RBAC.ReconcilePolicy() posts = %v, want %v", posts, wantPosts)
+	}
+}
+
+func TestRBAC_ReconcilePolicy_organizations(t *testing.T) {
+	policy, err := LoadPolicy([]byte(`
+managed_roles: [execute]
+grants:
+  - user: alice
+    role: execute
+    resource_type: job_template
+    resource: Deploy
+  - user: alice
+    role: execute
+    resource_type: job_template
+    resource: Deploy
+    resource_organization: Network Engineering
+  - team: Campus
+    role: execute
+    resource_type: job_template
+    resource: Deploy
+    resource_organization: Network Engineering
+  - team: Campus
+    team_organization: Security
+    role: execute
+    resource_type: job_template
+    resource: Deploy
+`))
+
+	if err != nil {
+		t.Fatalf("LoadPolicy() error = %v", err)
+	}
+
+	connection := newFakePolicyConnection()
+	rbac := NewRBAC(connection)
+
+	report, err := rbac.ReconcilePolicy(policy, false)
+
+	if err != nil {
+		t.Fatalf("RBAC.ReconcilePolicy() error = %v", err)
+	}
+
+	got := []string{}
+
+	for _, change := range report.Applied {
+		got = append(got, change.String())
+	}
+
+	want := []string{
+		"grant execute on job_template Deploy to team Campus",
+		"grant execute on job_template Deploy to user alice",
+		"revoke execute on job_template Deploy from user operator",
+	}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("RBAC.ReconcilePolicy() applied = %v, want %v", got, want)
+	}
+
+	wantCalls := []string{"POST teams/6/roles/", "POST users/1/roles/", "POST users/3/roles/"}
+
+	if calls := connection.Calls(http.MethodPost); !slices.Equal(calls, wantCalls) {
+		t.Errorf("RBAC.ReconcilePolicy() posts = %v, want %v", calls, wantCalls)
+	}
+
+	if calls := slices.DeleteFunc(connection.Calls(http.MethodGet), func(call string) bool { return call != "GET job_templates/7/object_roles/" }); len(calls) != 1 {
+		t.Errorf("RBAC.ReconcilePolicy() loaded the roles of job template 7 %d times, want once", len(calls))
+	}
+}
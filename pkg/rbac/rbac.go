@@ -0,0 +1,210 @@
+/*
+Package rbac provides a way to list, grant and revoke roles on resources for Ansible AAP
+
+Object roles are the roles of the controller API, role definitions and role assignments are the roles of AAP 2.5 and
+later.
+*/
+package rbac
+
+import (
+	"fmt"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/common"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/connection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/dataconversion"
+	"slices"
+	"strings"
+)
+
+// Resource URIs of the resources that have roles
+const (
+	ResourceJobTemplate         = "job_templates/"
+	ResourceWorkflowJobTemplate = "workflow_job_templates/"
+	ResourceInventory           = "inventories/"
+	ResourceProject             = "projects/"
+	ResourceCredential          = "credentials/"
+	ResourceOrganization        = "organizations/"
+	ResourceTeam                = "teams/"
+)
+
+// Roles of the resources, not every resource has every role
+const (
+	RoleAdmin   = "admin"
+	RoleUse     = "use"
+	RoleExecute = "execute"
+	RoleRead    = "read"
+	RoleUpdate  = "update"
+	RoleAdhoc   = "adhoc"
+	RoleMember  = "member"
+	RoleApprove = "approve"
+)
+
+// RBAC represents AAP role based access control
+type RBAC struct {
+	UserURI               string
+	TeamURI               string
+	RoleURI               string
+	RoleDefinitionURI     string
+	RoleUserAssignmentURI string
+	RoleTeamAssignmentURI string
+	connection            connection.BasicConnection
+	DataConversion        dataconversion.DataConverterInterface
+}
+
+// NewRBAC creates a new role based access control instance
+//
+//	:param basicConnection: The basic connection to use
+func NewRBAC(basicConnection connection.BasicConnection) *RBAC {
+	return &RBAC{
+		UserURI:               "users/",
+		TeamURI:               "teams/",
+		RoleURI:               "roles/",
+		RoleDefinitionURI:     "role_definitions/",
+		RoleUserAssignmentURI: "role_user_assignments/",
+		RoleTeamAssignmentURI: "role_team_assignments/",
+		connection:            basicConnection,
+		DataConversion:        dataconversion.NewDataConverter(),
+	}
+}
+
+// RoleName normalizes the display name of a role to one of the role constants, for example "Ad Hoc" to adhoc
+//
+//	:param name: The display name of the role
+func RoleName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, " ", ""))
+}
+
+// GetObjectRoles gets the roles of a resource
+//
+//	:param resourceURI: The resource URI, for example ResourceJobTemplate
+//	:param resourceID: The ID of the resource
+func (rbac *RBAC) GetObjectRoles(resourceURI string, resourceID int32) (roles []ObjectRoleSchema, err error) {
+	return common.GetAllPages[ObjectRoleSchema](rbac.connection, rbac.DataConversion, fmt.Sprintf("%s%d/object_roles/", resourceURI, resourceID), nil)
+}
+
+// GetObjectRole gets a role of a resource by role
+//
+//	:param resourceURI: The resource URI, for example ResourceJobTemplate
+//	:param resourceID: The ID of the resource
+//	:param role: The role, for example RoleExecute
+func (rbac *RBAC) GetObjectRole(resourceURI string, resourceID int32, role string) (objectRole ObjectRoleSchema, err error) {
+	roles, err := rbac.GetObjectRoles(resourceURI, resourceID)
+
+	if err != nil {
+		return objectRole, err
+	}
+
+	for _, objectRole := range roles {
+		if RoleName(objectRole.Name) == role {
+			return objectRole, nil
+		}
+	}
+
+	return objectRole, fmt.Errorf("no %s role found on %s%d", role, resourceURI, resourceID)
+}
+
+// GrantUserRole grants a role of a resource to a user
+//
+//	:param userID: The ID of the user
+//	:param resourceURI: The resource URI, for example ResourceJobTemplate
+//	:param resourceID: The ID of the resource
+//	:param role: The role, for example RoleExecute
+func (rbac *RBAC) GrantUserRole(userID int32, resourceURI string, resourceID int32, role string) (statusCode int, err error) {
+	return rbac.assignRole(fmt.Sprintf("%s%d/roles/", rbac.UserURI, userID), resourceURI, resourceID, role, false)
+}
+
+// RevokeUserRole revokes a role of a resource from a user
+//
+//	:param userID: The ID of the user
+//	:param resourceURI: The resource URI, for example ResourceJobTemplate
+//	:param resourceID: The ID of the resource
+//	:param role: The role, for example RoleExecute
+func (rbac *RBAC) RevokeUserRole(userID int32, resourceURI string, resourceID int32, role string) (statusCode int, err error) {
+	return rbac.assignRole(fmt.Sprintf("%s%d/roles/", rbac.UserURI, userID), resourceURI, resourceID, role, true)
+}
+
+// GrantTeamRole grants a role of a resource to a team
+//
+//	:param teamID: The ID of the team
+//	:param resourceURI: The resource URI, for example ResourceJobTemplate
+//	:param resourceID: The ID of the resource
+//	:param role: The role, for example RoleExecute
+func (rbac *RBAC) GrantTeamRole(teamID int32, resourceURI string, resourceID int32, role string) (statusCode int, err error) {
+	return rbac.assignRole(fmt.Sprintf("%s%d/roles/", rbac.TeamURI, teamID), resourceURI, resourceID, role, false)
+}
+
+// RevokeTeamRole revokes a role of a resource from a team
+//
+//	:param teamID: The ID of the team
+//	:param resourceURI: The resource URI, for example ResourceJobTemplate
+//	:param resourceID: The ID of the resource
+//	:param role: The role, for example RoleExecute
+func (rbac *RBAC) RevokeTeamRole(teamID int32, resourceURI string, resourceID int32, role string) (statusCode int, err error) {
+	return rbac.assignRole(fmt.Sprintf("%s%d/roles/", rbac.TeamURI, teamID), resourceURI, resourceID, role, true)
+}
+
+// assignRole associates or disassociates a role of a resource on the roles endpoint of a user or team
+//
+//	:param uri: The URI of the roles endpoint of the user or team
+//	:param resourceURI: The resource URI
+//	:param resourceID: The ID of the resource
+//	:param role: The role
+//	:param disassociate: Whether to disassociate instead of associate
+func (rbac *RBAC) assignRole(uri string, resourceURI string, resourceID int32, role string, disassociate bool) (statusCode int, err error) {
+	objectRole, err := rbac.GetObjectRole(resourceURI, resourceID, role)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return common.Associate(rbac.connection, uri, objectRole.ID, disassociate)
+}
+
+// GetAccessList gets the users that have access to a resource and the roles that give them access
+//
+//	:param resourceURI: The resource URI, for example ResourceJobTemplate
+//	:param resourceID: The ID of the resource
+func (rbac *RBAC) GetAccessList(resourceURI string, resourceID int32) (users []AccessListUserSchema, err error) {
+	return common.GetAllPages[AccessListUserSchema](rbac.connection, rbac.DataConversion, fmt.Sprintf("%s%d/access_list/", resourceURI, resourceID), nil)
+}
+
+// WhoCan gets the users that have a role on a resource, directly, through a team or through a role that implies it
+//
+// For example WhoCan(ResourceJobTemplate, 7, RoleExecute) gets the users that can launch job template 7.
+//
+//	:param resourceURI: The resource URI, for example ResourceJobTemplate
+//	:param resourceID: The ID of the resource
+//	:param role: The role, for example RoleExecute
+func (rbac *RBAC) WhoCan(resourceURI string, resourceID int32, role string) (users []AccessListUserSchema, err error) {
+	accessList, err := rbac.GetAccessList(resourceURI, resourceID)
+
+	if err != nil {
+		return []AccessListUserSchema{}, err
+	}
+
+	users = []AccessListUserSchema{}
+
+	for _, user := range accessList {
+		if user.HasRole(role) {
+			users = append(users, user)
+		}
+	}
+
+	return users, nil
+}
+
+// HasRole checks if an access list user has a role, a superuser has every role
+//
+//	:param role: The role, for example RoleExecute
+func (user AccessListUserSchema) HasRole(role string) bool {
+	if user.IsSuperuser {
+		return true
+	}
+
+	for _, entry := range slices.Concat(user.SummaryFields.DirectAccess, user.SummaryFields.IndirectAccess) {
+		if RoleName(entry.Role.Name) == role || slices.Contains(entry.DescendantRoles, role+"_role") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,143 @@
+package rbac
+
+// ObjectRoleRelatedResponseSchema is the schema for the related section of an object role
+type ObjectRoleRelatedResponseSchema struct {
+	Users string `json:"users" yaml:"users"`
+	Teams string `json:"teams" yaml:"teams"`
+}
+
+// ObjectRoleSummaryFieldsSchema is the schema for the summary fields of an object role
+type ObjectRoleSummaryFieldsSchema struct {
+	ResourceName            string `json:"resource_name" yaml:"resource_name"`
+	ResourceType            string `json:"resource_type" yaml:"resource_type"`
+	ResourceTypeDisplayName string `json:"resource_type_display_name" yaml:"resource_type_display_name"`
+	ResourceID              int32  `json:"resource_id" yaml:"resource_id"`
+}
+
+// ObjectRoleSchema is the schema for a role of a resource, for example the Execute role of a job template
+type ObjectRoleSchema struct {
+	ID            int32                           `json:"id" yaml:"id"`
+	Type          string                          `json:"type" yaml:"type"`
+	URL           string                          `json:"url" yaml:"url"`
+	Related       ObjectRoleRelatedResponseSchema `json:"related" yaml:"related"`
+	SummaryFields ObjectRoleSummaryFieldsSchema   `json:"summary_fields" yaml:"summary_fields"`
+	Name          string                          `json:"name" yaml:"name"`
+	Description   string                          `json:"description" yaml:"description"`
+}
+
+// ObjectRoleResponseSchema is the schema for an object role response
+type ObjectRoleResponseSchema struct {
+	Count    int32              `json:"count" yaml:"count"`
+	Next     string             `json:"next" yaml:"next"`
+	Previous string             `json:"previous" yaml:"previous"`
+	Results  []ObjectRoleSchema `json:"results" yaml:"results"`
+}
+
+// AccessRoleSchema is the schema for the role that gives a user access to a resource
+type AccessRoleSchema struct {
+	ID           int32  `json:"id" yaml:"id"`
+	Name         string `json:"name" yaml:"name"`
+	Description  string `json:"description" yaml:"description"`
+	ResourceName string `json:"resource_name" yaml:"resource_name"`
+	ResourceType string `json:"resource_type" yaml:"resource_type"`
+	TeamID       int32  `json:"team_id" yaml:"team_id"`
+	TeamName     string `json:"team_name" yaml:"team_name"`
+}
+
+// AccessEntrySchema is the schema for one way a user has access to a resource
+type AccessEntrySchema struct {
+	Role AccessRoleSchema `json:"role" yaml:"role"`
+	// DescendantRoles are the role fields the role implies, for example admin_role, execute_role and read_role
+	DescendantRoles []string `json:"descendant_roles" yaml:"descendant_roles"`
+}
+
+// AccessListSummaryFieldsSchema is the schema for the summary fields of an access list user
+type AccessListSummaryFieldsSchema struct {
+	DirectAccess   []AccessEntrySchema `json:"direct_access" yaml:"direct_access"`
+	IndirectAccess []AccessEntrySchema `json:"indirect_access" yaml:"indirect_access"`
+}
+
+// AccessListUserSchema is the schema for a user of the access list of a resource
+type AccessListUserSchema struct {
+	ID              int32                         `json:"id" yaml:"id"`
+	Type            string                        `json:"type" yaml:"type"`
+	URL             string                        `json:"url" yaml:"url"`
+	SummaryFields   AccessListSummaryFieldsSchema `json:"summary_fields" yaml:"summary_fields"`
+	Username        string                        `json:"username" yaml:"username"`
+	FirstName       string                        `json:"first_name" yaml:"first_name"`
+	LastName        string                        `json:"last_name" yaml:"last_name"`
+	Email           string                        `json:"email" yaml:"email"`
+	IsSuperuser     bool                          `json:"is_superuser" yaml:"is_superuser"`
+	IsSystemAuditor bool                          `json:"is_system_auditor" yaml:"is_system_auditor"`
+}
+
+// AccessListResponseSchema is the schema for an access list response
+type AccessListResponseSchema struct {
+	Count    int32                  `json:"count" yaml:"count"`
+	Next     string                 `json:"next" yaml:"next"`
+	Previous string                 `json:"previous" yaml:"previous"`
+	Results  []AccessListUserSchema `json:"results" yaml:"results"`
+}
+
+// RoleDefinitionRequestSchema is the schema for an AAP 2.5 role definition request
+type RoleDefinitionRequestSchema struct {
+	Name        string   `json:"name" yaml:"name"`
+	Description string   `json:"description" yaml:"description"`
+	Permissions []string `json:"permissions" yaml:"permissions"`
+	ContentType string   `json:"content_type,omitempty" yaml:"content_type,omitempty"`
+}
+
+// RoleDefinitionSchema is the schema for an AAP 2.5 role definition
+type RoleDefinitionSchema struct {
+	ID   int32  `json:"id" yaml:"id"`
+	Type string `json:"type" yaml:"type"`
+	URL  string `json:"url" yaml:"url"`
+	RoleDefinitionRequestSchema
+	Managed  bool   `json:"managed" yaml:"managed"`
+	Created  string `json:"created" yaml:"created"`
+	Modified string `json:"modified" yaml:"modified"`
+}
+
+// RoleDefinitionResponseSchema is the schema for a role definition response
+type RoleDefinitionResponseSchema struct {
+	Count    int32                  `json:"count" yaml:"count"`
+	Next     string                 `json:"next" yaml:"next"`
+	Previous string                 `json:"previous" yaml:"previous"`
+	Results  []RoleDefinitionSchema `json:"results" yaml:"results"`
+}
+
+// RoleUserAssignmentRequestSchema is the schema for a request to assign a role definition to a user
+//
+// The object ID is empty for a role definition without a content type, which applies to the whole system.
+type RoleUserAssignmentRequestSchema struct {
+	RoleDefinition int32  `json:"role_definition" yaml:"role_definition"`
+	User           int32  `json:"user" yaml:"user"`
+	ObjectID       string `json:"object_id,omitempty" yaml:"object_id,omitempty"`
+}
+
+// RoleTeamAssignmentRequestSchema is the schema for a request to assign a role definition to a team
+type RoleTeamAssignmentRequestSchema struct {
+	RoleDefinition int32  `json:"role_definition" yaml:"role_definition"`
+	Team           int32  `json:"team" yaml:"team"`
+	ObjectID       string `json:"object_id,omitempty" yaml:"object_id,omitempty"`
+}
+
+// RoleAssignmentSchema is the schema for a role user assignment or role team assignment
+type RoleAssignmentSchema struct {
+	ID             int32  `json:"id" yaml:"id"`
+	URL            string `json:"url" yaml:"url"`
+	Created        string `json:"created" yaml:"created"`
+	RoleDefinition int32  `json:"role_definition" yaml:"role_definition"`
+	User           int32  `json:"user,omitempty" yaml:"user,omitempty"`
+	Team           int32  `json:"team,omitempty" yaml:"team,omitempty"`
+	ContentType    string `json:"content_type" yaml:"content_type"`
+	ObjectID       string `json:"object_id" yaml:"object_id"`
+}
+
+// RoleAssignmentResponseSchema is the schema for a role assignment response
+type RoleAssignmentResponseSchema struct {
+	Count    int32                  `json:"count" yaml:"count"`
+	Next     string                 `json:"next" yaml:"next"`
+	Previous string                 `json:"previous" yaml:"previous"`
+	Results  []RoleAssignmentSchema `json:"results" yaml:"results"`
+}
@@ -0,0 +1,121 @@
+package rbac
+
+import (
+	"github.com/btr1975/go-ansible-aap-api-client/internal/fakeconnection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/common"
+	"net/http"
+	"testing"
+)
+
+// newFakeRoleConnection answers with the object roles and access list of job template 7 and accepts role associations
+func newFakeRoleConnection() *fakeconnection.Connection {
+	connection := fakeconnection.New().
+		HandleJSON(http.MethodGet, "job_templates/7/object_roles/", ObjectRoleResponseSchema{Count: 3, Results: []ObjectRoleSchema{
+			{ID: 70, Name: "Admin"},
+			{ID: 71, Name: "Execute"},
+			{ID: 72, Name: "Read"},
+		}}).
+		HandleJSON(http.MethodGet, "job_templates/7/access_list/", AccessListResponseSchema{Count: 4, Results: []AccessListUserSchema{
+			{ID: 1, Username: "root", IsSuperuser: true},
+			{ID: 2, Username: "owner", SummaryFields: AccessListSummaryFieldsSchema{
+				DirectAccess: []AccessEntrySchema{{Role: AccessRoleSchema{Name: "Admin"}, DescendantRoles: []string{"admin_role", "execute_role", "read_role"}}},
+			}},
+			{ID: 3, Username: "operator", SummaryFields: AccessListSummaryFieldsSchema{
+				IndirectAccess: []AccessEntrySchema{{Role: AccessRoleSchema{Name: "Execute", TeamName: "Campus"}}},
+			}},
+			{ID: 4, Username: "auditor", IsSystemAuditor: true, SummaryFields: AccessListSummaryFieldsSchema{
+				DirectAccess: []AccessEntrySchema{{Role: AccessRoleSchema{Name: "Read"}, DescendantRoles: []string{"read_role"}}},
+			}},
+		}})
+
+	connection.HandlePrefix(http.MethodPost, "", func(fakeconnection.Request) (*http.Response, error) {
+		return fakeconnection.NoContent()
+	})
+
+	return connection
+}
+
+// associations gets the role association posted to each URI of a fake connection
+func associations(connection *fakeconnection.Connection) map[string]common.AssociationRequestSchema {
+	posts := map[string]common.AssociationRequestSchema{}
+
+	for _, request := range connection.Requests() {
+		if request.Method != http.MethodPost {
+			continue
+		}
+
+		association := common.AssociationRequestSchema{}
+		_ = request.Decode(&association)
+		posts[request.URI] = association
+	}
+
+	return posts
+}
+
+func TestRBAC_GrantAndRevoke(t *testing.T) {
+	connection := newFakeRoleConnection()
+	rbac := NewRBAC(connection)
+
+	if _, err := rbac.GrantTeamRole(5, ResourceJobTemplate, 7, RoleExecute); err != nil {
+		t.Fatalf("RBAC.GrantTeamRole() error = %v", err)
+	}
+
+	if _, err := rbac.RevokeUserRole(3, ResourceJobTemplate, 7, RoleAdmin); err != nil {
+		t.Fatalf("RBAC.RevokeUserRole() error = %v", err)
+	}
+
+	want := map[string]common.AssociationRequestSchema{
+		"teams/5/roles/": {ID: 71},
+		"users/3/roles/": {ID: 70, Disassociate: true},
+	}
+
+	posts := associations(connection)
+
+	for uri, association := range want {
+		if posts[uri] != association {
+			t.Errorf("RBAC post %s = %+v, want %+v", uri, posts[uri], association)
+		}
+	}
+
+	if _, err := rbac.GrantUserRole(3, ResourceJobTemplate, 7, RoleAdhoc); err == nil {
+		t.Errorf("RBAC.GrantUserRole() error = nil, want missing role error")
+	}
+}
+
+func TestRBAC_WhoCan(t *testing.T) {
+	rbac := NewRBAC(newFakeRoleConnection())
+
+	tests := []struct {
+		role string
+		want []string
+	}{
+		{role: RoleExecute, want: []string{"root", "owner", "operator"}},
+		{role: RoleAdmin, want: []string{"root", "owner"}},
+		{role: RoleRead, want: []string{"root", "owner", "auditor"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.role, func(t *testing.T) {
+			users, err := rbac.WhoCan(ResourceJobTemplate, 7, tt.role)
+
+			if err != nil {
+				t.Fatalf("RBAC.WhoCan() error = %v", err)
+			}
+
+			got := []string{}
+
+			for _, user := range users {
+				got = append(got, user.Username)
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("RBAC.WhoCan() = %v, want %v", got, tt.want)
+			}
+
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("RBAC.WhoCan() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package rbac
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/common"
+	"strconv"
+)
+
+// RoleAssignmentFilter filters the role assignments to list, empty fields are not filtered on
+type RoleAssignmentFilter struct {
+	RoleDefinition int32
+	User           int32
+	Team           int32
+	ObjectID       string
+	// ContentType is the model of the content type, for example jobtemplate
+	ContentType string
+}
+
+// params converts the filter to query params
+func (filter RoleAssignmentFilter) params() map[string]string {
+	params := map[string]string{}
+
+	if filter.RoleDefinition != 0 {
+		params["role_definition"] = strconv.Itoa(int(filter.RoleDefinition))
+	}
+
+	if filter.User != 0 {
+		params["user"] = strconv.Itoa(int(filter.User))
+	}
+
+	if filter.Team != 0 {
+		params["team"] = strconv.Itoa(int(filter.Team))
+	}
+
+	if filter.ObjectID != "" {
+		params["object_id"] = filter.ObjectID
+	}
+
+	if filter.ContentType != "" {
+		params["content_type__model"] = filter.ContentType
+	}
+
+	return params
+}
+
+// GetRoleDefinitions gets the role definitions, optionally only the ones of a content type
+//
+//	:param contentType: The model of the content type, for example jobtemplate, empty gets every role definition
+func (rbac *RBAC) GetRoleDefinitions(contentType string) (roleDefinitions []RoleDefinitionSchema, err error) {
+	params := map[string]string{}
+
+	if contentType != "" {
+		params["content_type__model"] = contentType
+	}
+
+	return common.GetAllPages[RoleDefinitionSchema](rbac.connection, rbac.DataConversion, rbac.RoleDefinitionURI, params)
+}
+
+// GetRoleDefinitionID gets a role definition ID by name
+//
+//	:param name: The name of the role definition, for example JobTemplate Execute
+func (rbac *RBAC) GetRoleDefinitionID(name string) (id int32, err error) {
+	roleDefinitions, err := common.GetAllPages[RoleDefinitionSchema](rbac.connection, rbac.DataConversion, rbac.RoleDefinitionURI, map[string]string{"name": name})
+
+	if err != nil {
+		return 0, err
+	}
+
+	if len(roleDefinitions) == 0 {
+		return 0, fmt.Errorf("no role definition found with name %s", name)
+	}
+
+	return roleDefinitions[0].ID, nil
+}
+
+// CreateRoleDefinition creates a custom role definition
+//
+//	:param roleDefinitionRequest: The role definition request schema to use
+func (rbac *RBAC) CreateRoleDefinition(roleDefinitionRequest RoleDefinitionRequestSchema) (schemaResponse RoleDefinitionSchema, err error) {
+	schemaResponse = RoleDefinitionSchema{}
+
+	err = rbac.post(rbac.RoleDefinitionURI, roleDefinitionRequest, &schemaResponse)
+
+	return schemaResponse, err
+}
+
+// DeleteRoleDefinition deletes a custom role definition by ID
+//
+//	:param id: The ID of the role definition to delete
+func (rbac *RBAC) DeleteRoleDefinition(id int32) (statusCode int, err error) {
+	return rbac.delete(fmt.Sprintf("%s%d/", rbac.RoleDefinitionURI, id))
+}
+
+// GetRoleUserAssignments gets the role user assignments that match a filter
+//
+//	:param filter: The filter to use
+func (rbac *RBAC) GetRoleUserAssignments(filter RoleAssignmentFilter) (assignments []RoleAssignmentSchema, err error) {
+	return common.GetAllPages[RoleAssignmentSchema](rbac.connection, rbac.DataConversion, rbac.RoleUserAssignmentURI, filter.params())
+}
+
+// GetRoleTeamAssignments gets the role team assignments that match a filter
+//
+//	:param filter: The filter to use
+func (rbac *RBAC) GetRoleTeamAssignments(filter RoleAssignmentFilter) (assignments []RoleAssignmentSchema, err error) {
+	return common.GetAllPages[RoleAssignmentSchema](rbac.connection, rbac.DataConversion, rbac.RoleTeamAssignmentURI, filter.params())
+}
+
+// AssignUserRoleDefinition assigns a role definition on an object to a user
+//
+//	:param roleDefinitionID: The ID of the role definition
+//	:param userID: The ID of the user
+//	:param objectID: The ID of the object, 0 for a role definition that applies to the whole system
+func (rbac *RBAC) AssignUserRoleDefinition(roleDefinitionID int32, userID int32, objectID int32) (schemaResponse RoleAssignmentSchema, err error) {
+	schemaResponse = RoleAssignmentSchema{}
+
+	assignmentRequest := RoleUserAssignmentRequestSchema{RoleDefinition: roleDefinitionID, User: userID, ObjectID: objectIDString(objectID)}
+
+	err = rbac.post(rbac.RoleUserAssignmentURI, assignmentRequest, &schemaResponse)
+
+	return schemaResponse, err
+}
+
+// AssignTeamRoleDefinition assigns a role definition on an object to a team
+//
+//	:param roleDefinitionID: The ID of the role definition
+//	:param teamID: The ID of the team
+//	:param objectID: The ID of the object, 0 for a role definition that applies to the whole system
+func (rbac *RBAC) AssignTeamRoleDefinition(roleDefinitionID int32, teamID int32, objectID int32) (schemaResponse RoleAssignmentSchema, err error) {
+	schemaResponse = RoleAssignmentSchema{}
+
+	assignmentRequest := RoleTeamAssignmentRequestSchema{RoleDefinition: roleDefinitionID, Team: teamID, ObjectID: objectIDString(objectID)}
+
+	err = rbac.post(rbac.RoleTeamAssignmentURI, assignmentRequest, &schemaResponse)
+
+	return schemaResponse, err
+}
+
+// RemoveRoleUserAssignment removes a role user assignment by ID
+//
+//	:param id: The ID of the role user assignment
+func (rbac *RBAC) RemoveRoleUserAssignment(id int32) (statusCode int, err error) {
+	return rbac.delete(fmt.Sprintf("%s%d/", rbac.RoleUserAssignmentURI, id))
+}
+
+// RemoveRoleTeamAssignment removes a role team assignment by ID
+//
+//	:param id: The ID of the role team assignment
+func (rbac *RBAC) RemoveRoleTeamAssignment(id int32) (statusCode int, err error) {
+	return rbac.delete(fmt.Sprintf("%s%d/", rbac.RoleTeamAssignmentURI, id))
+}
+
+// objectIDString converts an object ID to the string the role assignment endpoints expect, empty for 0
+//
+//	:param objectID: The ID of the object
+func objectIDString(objectID int32) string {
+	if objectID == 0 {
+		return ""
+	}
+
+	return strconv.Itoa(int(objectID))
+}
+
+// post posts a request and converts the response
+//
+//	:param uri: The URI to post to
+//	:param request: The request to send
+//	:param schemaResponse: A pointer to the struct to convert the response into
+func (rbac *RBAC) post(uri string, request any, schemaResponse any) (err error) {
+	data, err := json.Marshal(request)
+
+	if err != nil {
+		return err
+	}
+
+	response, err := rbac.connection.Post(uri, data)
+
+	if err != nil {
+		return err
+	}
+
+	return rbac.DataConversion.ResponseBodyToStruct(schemaResponse, *response)
+}
+
+// delete deletes an object by URI
+//
+//	:param uri: The URI of the object to delete
+func (rbac *RBAC) delete(uri string) (statusCode int, err error) {
+	response, err := rbac.connection.Delete(uri, nil)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response.StatusCode, nil
+}
@@ -0,0 +1,346 @@
+package schedules
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Rule frequencies supported by AAP
+const (
+	FrequencyMinutely = "MINUTELY"
+	FrequencyHourly   = "HOURLY"
+	FrequencyDaily    = "DAILY"
+	FrequencyWeekly   = "WEEKLY"
+	FrequencyMonthly  = "MONTHLY"
+	FrequencyYearly   = "YEARLY"
+)
+
+// Rule weekdays
+const (
+	Monday    = "MO"
+	Tuesday   = "TU"
+	Wednesday = "WE"
+	Thursday  = "TH"
+	Friday    = "FR"
+	Saturday  = "SA"
+	Sunday    = "SU"
+)
+
+// rruleTimeFormat is the format of the date times in an rrule
+const rruleTimeFormat = "20060102T150405"
+
+// weekdayPattern matches a weekday with an optional ordinal, for example MO, 1MO or -1FR
+var weekdayPattern = regexp.MustCompile(`^[+-]?([1-9]|[1-4][0-9]|5[0-3])?(MO|TU|WE|TH|FR|SA|SU)$`)
+
+// NthDay gets a weekday with an ordinal for monthly and yearly rules, for example the last Friday is NthDay(-1, Friday)
+//
+//	:param n: The ordinal, negative counts from the end
+//	:param day: The weekday
+func NthDay(n int, day string) string {
+	return fmt.Sprintf("%d%s", n, day)
+}
+
+// Rule is a recurrence rule, RRULE when it is the rule of a recurrence and EXRULE when it is an exclusion
+type Rule struct {
+	Frequency  string
+	Interval   int
+	ByDay      []string
+	ByMonthDay []int
+	ByMonth    []int
+	ByHour     []int
+	ByMinute   []int
+	Count      int
+	Until      time.Time
+}
+
+// NewRule creates a new rule with an interval of 1
+//
+//	:param frequency: The frequency (FrequencyMinutely, FrequencyHourly, FrequencyDaily, FrequencyWeekly, FrequencyMonthly, FrequencyYearly)
+func NewRule(frequency string) *Rule {
+	return &Rule{Frequency: frequency, Interval: 1}
+}
+
+// Every sets the interval, for example every 2 weeks
+//
+//	:param interval: The number of frequency periods between occurrences
+func (rule *Rule) Every(interval int) *Rule {
+	rule.Interval = interval
+
+	return rule
+}
+
+// OnDays adds weekdays to the rule
+//
+//	:param days: The weekdays, optionally with an ordinal from NthDay
+func (rule *Rule) OnDays(days ...string) *Rule {
+	rule.ByDay = append(rule.ByDay, days...)
+
+	return rule
+}
+
+// OnMonthDays adds days of the month to the rule
+//
+//	:param days: The days of the month, negative counts from the end
+func (rule *Rule) OnMonthDays(days ...int) *Rule {
+	rule.ByMonthDay = append(rule.ByMonthDay, days...)
+
+	return rule
+}
+
+// InMonths adds months to the rule
+//
+//	:param months: The months, 1 to 12
+func (rule *Rule) InMonths(months ...int) *Rule {
+	rule.ByMonth = append(rule.ByMonth, months...)
+
+	return rule
+}
+
+// AtHours adds hours to the rule
+//
+//	:param hours: The hours, 0 to 23
+func (rule *Rule) AtHours(hours ...int) *Rule {
+	rule.ByHour = append(rule.ByHour, hours...)
+
+	return rule
+}
+
+// AtMinutes adds minutes to the rule
+//
+//	:param minutes: The minutes, 0 to 59
+func (rule *Rule) AtMinutes(minutes ...int) *Rule {
+	rule.ByMinute = append(rule.ByMinute, minutes...)
+
+	return rule
+}
+
+// Times ends the rule after a number of occurrences, it can not be used with UntilTime
+//
+//	:param count: The number of occurrences
+func (rule *Rule) Times(count int) *Rule {
+	rule.Count = count
+
+	return rule
+}
+
+// UntilTime ends the rule at a time, it can not be used with Times
+//
+//	:param until: The time of the last possible occurrence
+func (rule *Rule) UntilTime(until time.Time) *Rule {
+	rule.Until = until
+
+	return rule
+}
+
+// Validate validates the rule
+func (rule *Rule) Validate() (err error) {
+	var errs []error
+
+	switch rule.Frequency {
+	case FrequencyMinutely, FrequencyHourly, FrequencyDaily, FrequencyWeekly, FrequencyMonthly, FrequencyYearly:
+	default:
+		errs = append(errs, fmt.Errorf("invalid frequency %q", rule.Frequency))
+	}
+
+	if rule.Interval < 1 {
+		errs = append(errs, fmt.Errorf("invalid interval %d, must be at least 1", rule.Interval))
+	}
+
+	if rule.Count < 0 {
+		errs = append(errs, fmt.Errorf("invalid count %d", rule.Count))
+	}
+
+	if rule.Count > 0 && !rule.Until.IsZero() {
+		errs = append(errs, errors.New("count and until can not both be set"))
+	}
+
+	for _, day := range rule.ByDay {
+		if !weekdayPattern.MatchString(day) {
+			errs = append(errs, fmt.Errorf("invalid weekday %q", day))
+		} else if day != strings.TrimLeft(day, "+-0123456789") && rule.Frequency != FrequencyMonthly && rule.Frequency != FrequencyYearly {
+			errs = append(errs, fmt.Errorf("weekday %q with an ordinal is only valid for monthly and yearly rules", day))
+		}
+	}
+
+	errs = append(errs, validateRange("month day", rule.ByMonthDay, -31, 31, true)...)
+	errs = append(errs, validateRange("month", rule.ByMonth, 1, 12, false)...)
+	errs = append(errs, validateRange("hour", rule.ByHour, 0, 23, false)...)
+	errs = append(errs, validateRange("minute", rule.ByMinute, 0, 59, false)...)
+
+	return errors.Join(errs...)
+}
+
+// validateRange validates that values are within a range
+//
+//	:param name: The name of the values
+//	:param values: The values to validate
+//	:param low: The lowest valid value
+//	:param high: The highest valid value
+//	:param noZero: Whether 0 is invalid
+func validateRange(name string, values []int, low int, high int, noZero bool) (errs []error) {
+	for _, value := range values {
+		if value < low || value > high || (noZero && value == 0) {
+			errs = append(errs, fmt.Errorf("invalid %s %d", name, value))
+		}
+	}
+
+	return errs
+}
+
+// String gets the rule parts, for example FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR
+func (rule *Rule) String() string {
+	parts := []string{
+		"FREQ=" + rule.Frequency,
+		"INTERVAL=" + strconv.Itoa(rule.Interval),
+	}
+
+	if len(rule.ByDay) > 0 {
+		parts = append(parts, "BYDAY="+strings.Join(rule.ByDay, ","))
+	}
+
+	parts = appendInts(parts, "BYMONTHDAY", rule.ByMonthDay)
+	parts = appendInts(parts, "BYMONTH", rule.ByMonth)
+	parts = appendInts(parts, "BYHOUR", rule.ByHour)
+	parts = appendInts(parts, "BYMINUTE", rule.ByMinute)
+
+	if rule.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(rule.Count))
+	}
+
+	if !rule.Until.IsZero() {
+		parts = append(parts, "UNTIL="+rule.Until.UTC().Format(rruleTimeFormat)+"Z")
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// appendInts appends a rule part of integers when there are any
+//
+//	:param parts: The rule parts
+//	:param name: The name of the rule part
+//	:param values: The values of the rule part
+func appendInts(parts []string, name string, values []int) []string {
+	if len(values) == 0 {
+		return parts
+	}
+
+	texts := make([]string, 0, len(values))
+
+	for _, value := range values {
+		texts = append(texts, strconv.Itoa(value))
+	}
+
+	return append(parts, name+"="+strings.Join(texts, ","))
+}
+
+// Recurrence is the start, rule and exclusions of a schedule, built into the rrule field of a schedule
+type Recurrence struct {
+	Start      time.Time
+	Rule       *Rule
+	Exclusions []*Rule
+}
+
+// NewRecurrence creates a new recurrence, the time zone of the start is the time zone of the schedule
+//
+//	:param start: The start of the recurrence, in UTC or an IANA time zone location such as America/New_York
+//	:param rule: The rule of the recurrence
+func NewRecurrence(start time.Time, rule *Rule) *Recurrence {
+	return &Recurrence{Start: start, Rule: rule, Exclusions: []*Rule{}}
+}
+
+// Exclude adds an exclusion rule, occurrences that match it are skipped
+//
+//	:param rule: The exclusion rule
+func (recurrence *Recurrence) Exclude(rule *Rule) *Recurrence {
+	recurrence.Exclusions = append(recurrence.Exclusions, rule)
+
+	return recurrence
+}
+
+// Build validates the recurrence and builds the rrule of a schedule
+//
+// For example DTSTART;TZID=America/New_York:20250106T020000 RRULE:FREQ=WEEKLY;INTERVAL=1;BYDAY=MO
+func (recurrence *Recurrence) Build() (rrule string, err error) {
+	var errs []error
+
+	if recurrence.Start.IsZero() {
+		errs = append(errs, errors.New("recurrence has no start"))
+	}
+
+	location := recurrence.Start.Location().String()
+
+	if location == "Local" {
+		errs = append(errs, errors.New("recurrence start must be in UTC or a named time zone location, not Local"))
+	} else if err = validateLocation(recurrence.Start); err != nil {
+		errs = append(errs, err)
+	}
+
+	if recurrence.Rule == nil {
+		errs = append(errs, errors.New("recurrence has no rule"))
+	} else if err = recurrence.Rule.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("rule: %w", err))
+	}
+
+	for i, exclusion := range recurrence.Exclusions {
+		if exclusion == nil {
+			errs = append(errs, fmt.Errorf("exclusion %d has no rule", i))
+		} else if err = exclusion.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("exclusion %d: %w", i, err))
+		}
+	}
+
+	if err = errors.Join(errs...); err != nil {
+		return "", err
+	}
+
+	var dtstart string
+
+	if location == "UTC" {
+		dtstart = "DTSTART:" + recurrence.Start.Format(rruleTimeFormat) + "Z"
+	} else {
+		dtstart = fmt.Sprintf("DTSTART;TZID=%s:%s", location, recurrence.Start.Format(rruleTimeFormat))
+	}
+
+	parts := []string{dtstart, "RRULE:" + recurrence.Rule.String()}
+
+	for _, exclusion := range recurrence.Exclusions {
+		parts = append(parts, "EXRULE:"+exclusion.String())
+	}
+
+	return strings.Join(parts, " "), nil
+}
+
+// validateLocation validates the time zone of a start is UTC or an IANA Area/Location the server can load, fixed zones
+// and abbreviations such as EST are rejected as the server would not read them the same way
+//
+//	:param start: The start of the recurrence
+func validateLocation(start time.Time) (err error) {
+	location := start.Location().String()
+
+	if location == "UTC" {
+		return nil
+	}
+
+	if !strings.Contains(location, "/") {
+		return fmt.Errorf("recurrence start time zone %q is not an IANA time zone location such as America/New_York", location)
+	}
+
+	loaded, err := time.LoadLocation(location)
+
+	if err != nil {
+		return fmt.Errorf("recurrence start time zone %q can not be loaded: %w", location, err)
+	}
+
+	_, offset := start.Zone()
+	_, loadedOffset := start.In(loaded).Zone()
+
+	if offset != loadedOffset {
+		return fmt.Errorf("recurrence start time zone %q does not match the IANA time zone location of that name", location)
+	}
+
+	return nil
+}
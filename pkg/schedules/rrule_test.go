@@ -0,0 +1,112 @@
+package schedules
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRecurrence_Build(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+
+	if err != nil {
+		t.Skipf("time zone database not available: %v", err)
+	}
+
+	start := time.Date(2025, time.January, 6, 2, 0, 0, 0, newYork)
+
+	tests := []struct {
+		name       string
+		recurrence *Recurrence
+		want       string
+		wantErr    []string
+	}{
+		{
+			name:       "Test Build weekly with time zone",
+			recurrence: NewRecurrence(start, NewRule(FrequencyWeekly).OnDays(Monday, Thursday).Times(10)),
+			want:       "DTSTART;TZID=America/New_York:20250106T020000 RRULE:FREQ=WEEKLY;INTERVAL=1;BYDAY=MO,TH;COUNT=10",
+		},
+		{
+			name: "Test Build UTC until with exclusion",
+			recurrence: NewRecurrence(start.UTC(), NewRule(FrequencyDaily).Every(2).UntilTime(time.Date(2025, time.March, 1, 0, 0, 0, 0, newYork))).
+				Exclude(NewRule(FrequencyMonthly).OnDays(NthDay(-1, Friday))),
+			want: "DTSTART:20250106T070000Z RRULE:FREQ=DAILY;INTERVAL=2;UNTIL=20250301T050000Z EXRULE:FREQ=MONTHLY;INTERVAL=1;BYDAY=-1FR",
+		},
+		{
+			name:       "Test Build monthly on month days and hours",
+			recurrence: NewRecurrence(start, NewRule(FrequencyMonthly).OnMonthDays(1, -1).AtHours(2).AtMinutes(30)),
+			want:       "DTSTART;TZID=America/New_York:20250106T020000 RRULE:FREQ=MONTHLY;INTERVAL=1;BYMONTHDAY=1,-1;BYHOUR=2;BYMINUTE=30",
+		},
+		{
+			name: "Test Build invalid",
+			recurrence: NewRecurrence(start, NewRule("SECONDLY").Every(0).OnDays("XX", NthDay(2, Monday)).OnMonthDays(0).Times(3).UntilTime(start)).
+				Exclude(NewRule(FrequencyYearly).InMonths(13)),
+			wantErr: []string{
+				`invalid frequency "SECONDLY"`,
+				"invalid interval 0",
+				"count and until can not both be set",
+				`invalid weekday "XX"`,
+				`weekday "2MO" with an ordinal is only valid for monthly and yearly rules`,
+				"invalid month day 0",
+				"exclusion 0: invalid month 13",
+			},
+		},
+		{
+			name:       "Test Build local start",
+			recurrence: NewRecurrence(time.Date(2025, time.January, 6, 2, 0, 0, 0, time.Local), NewRule(FrequencyDaily)),
+			wantErr:    []string{"not Local"},
+		},
+		{
+			name:       "Test Build fixed zone start",
+			recurrence: NewRecurrence(time.Date(2025, time.January, 6, 2, 0, 0, 0, time.FixedZone("", -5*60*60)), NewRule(FrequencyDaily)),
+			wantErr:    []string{"is not an IANA time zone location"},
+		},
+		{
+			name:       "Test Build abbreviation start",
+			recurrence: NewRecurrence(time.Date(2025, time.January, 6, 2, 0, 0, 0, time.FixedZone("EST", -5*60*60)), NewRule(FrequencyDaily)),
+			wantErr:    []string{`time zone "EST" is not an IANA time zone location`},
+		},
+		{
+			name:       "Test Build fixed zone with an IANA name",
+			recurrence: NewRecurrence(time.Date(2025, time.January, 6, 2, 0, 0, 0, time.FixedZone("America/New_York", 0)), NewRule(FrequencyDaily)),
+			wantErr:    []string{"does not match the IANA time zone location"},
+		},
+		{
+			name:       "Test Build unknown zone name",
+			recurrence: NewRecurrence(time.Date(2025, time.January, 6, 2, 0, 0, 0, time.FixedZone("Mars/Olympus_Mons", 0)), NewRule(FrequencyDaily)),
+			wantErr:    []string{"can not be loaded"},
+		},
+		{
+			name:       "Test Build nil exclusion",
+			recurrence: NewRecurrence(start, NewRule(FrequencyDaily)).Exclude(nil),
+			wantErr:    []string{"exclusion 0 has no rule"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.recurrence.Build()
+
+			if len(tt.wantErr) == 0 {
+				if err != nil {
+					t.Fatalf("Recurrence.Build() error = %v", err)
+				}
+
+				if got != tt.want {
+					t.Errorf("Recurrence.Build() = %s, want %s", got, tt.want)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("Recurrence.Build() = %s, want error %v", got, tt.wantErr)
+			}
+
+			for _, want := range tt.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("Recurrence.Build() error = %v, want %s", err, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,294 @@
+/*
+Package schedules provides a way to manipulate schedules of job templates, workflows, projects and inventory sources for Ansible AAP
+*/
+package schedules
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/common"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/connection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/dataconversion"
+	"time"
+)
+
+// Parent URIs of the resources that can have schedules
+const (
+	ParentJobTemplate         = "job_templates/"
+	ParentWorkflowJobTemplate = "workflow_job_templates/"
+	ParentProject             = "projects/"
+	ParentInventorySource     = "inventory_sources/"
+)
+
+// Schedule represents an AAP schedule
+type Schedule struct {
+	URI            string
+	connection     connection.BasicConnection
+	DataConversion dataconversion.DataConverterInterface
+}
+
+// NewSchedule creates a new schedule instance
+//
+//	:param basicConnection: The basic connection to use
+func NewSchedule(basicConnection connection.BasicConnection) *Schedule {
+	return &Schedule{
+		URI:            "schedules/",
+		connection:     basicConnection,
+		DataConversion: dataconversion.NewDataConverter(),
+	}
+}
+
+// GetAllSchedules gets all schedules
+func (schedule *Schedule) GetAllSchedules() (schemaResponse ScheduleResponseSchema, err error) {
+	schemaResponse = ScheduleResponseSchema{}
+
+	response, err := schedule.connection.Get(schedule.URI, nil)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = schedule.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// GetSchedule gets a schedule by name
+//
+//	:param name: The name of the schedule to get
+func (schedule *Schedule) GetSchedule(name string) (schemaResponse ScheduleResponseSchema, err error) {
+	schemaResponse = ScheduleResponseSchema{}
+
+	params := map[string]string{
+		"name": name,
+	}
+
+	response, err := schedule.connection.Get(schedule.URI, params)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = schedule.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// GetScheduleID gets a schedule ID by name
+//
+//	:param name: The name of the schedule to get
+func (schedule *Schedule) GetScheduleID(name string) (id int32, err error) {
+	schemaResponse, err := schedule.GetSchedule(name)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if len(schemaResponse.Results) > 1 {
+		return 0, fmt.Errorf("more than one schedule found with name %s", name)
+	}
+
+	if len(schemaResponse.Results) == 0 {
+		return 0, fmt.Errorf("no schedule found with name %s", name)
+	}
+
+	return schemaResponse.Results[0].ID, nil
+}
+
+// GetScheduleByID gets a schedule by ID
+//
+//	:param id: The ID of the schedule to get
+func (schedule *Schedule) GetScheduleByID(id int32) (schemaResponse ScheduleResponseSingleSchema, err error) {
+	schemaResponse = ScheduleResponseSingleSchema{}
+
+	uri := fmt.Sprintf("%s%d/", schedule.URI, id)
+
+	response, err := schedule.connection.Get(uri, nil)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = schedule.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// GetSchedulesFor gets every schedule of a job template, workflow job template, project or inventory source
+//
+//	:param parentURI: The parent URI (ParentJobTemplate, ParentWorkflowJobTemplate, ParentProject, ParentInventorySource)
+//	:param parentID: The ID of the parent
+func (schedule *Schedule) GetSchedulesFor(parentURI string, parentID int32) (schedules []ScheduleResponseSingleSchema, err error) {
+	uri := fmt.Sprintf("%s%d/schedules/", parentURI, parentID)
+
+	return common.GetAllPages[ScheduleResponseSingleSchema](schedule.connection, schedule.DataConversion, uri, nil)
+}
+
+// CreateSchedule creates a new schedule on a job template, workflow job template, project or inventory source
+//
+//	:param parentURI: The parent URI (ParentJobTemplate, ParentWorkflowJobTemplate, ParentProject, ParentInventorySource)
+//	:param parentID: The ID of the parent
+//	:param scheduleRequest: The schedule request schema to use
+func (schedule *Schedule) CreateSchedule(parentURI string, parentID int32, scheduleRequest ScheduleRequestSchema) (schemaResponse ScheduleResponseSingleSchema, err error) {
+	schemaResponse = ScheduleResponseSingleSchema{}
+
+	uri := fmt.Sprintf("%s%d/schedules/", parentURI, parentID)
+
+	data, err := json.Marshal(scheduleRequest)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	response, err := schedule.connection.Post(uri, data)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = schedule.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// UpdateSchedule updates the fields of a schedule by ID that are set in the request
+//
+//	:param id: The ID of the schedule to update
+//	:param scheduleRequest: The schedule update request schema to use
+func (schedule *Schedule) UpdateSchedule(id int32, scheduleRequest ScheduleUpdateRequestSchema) (schemaResponse ScheduleResponseSingleSchema, err error) {
+	return schedule.patchSchedule(id, scheduleRequest)
+}
+
+// DeleteSchedule deletes a schedule by ID
+//
+//	:param id: The ID of the schedule to delete
+func (schedule *Schedule) DeleteSchedule(id int32) (statusCode int, err error) {
+	uri := fmt.Sprintf("%s%d/", schedule.URI, id)
+
+	response, err := schedule.connection.Delete(uri, nil)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response.StatusCode, nil
+}
+
+// EnableSchedule enables a schedule by ID
+//
+//	:param id: The ID of the schedule to enable
+func (schedule *Schedule) EnableSchedule(id int32) (schemaResponse ScheduleResponseSingleSchema, err error) {
+	return schedule.patchSchedule(id, ScheduleEnabledRequestSchema{Enabled: true})
+}
+
+// DisableSchedule disables a schedule by ID
+//
+//	:param id: The ID of the schedule to disable
+func (schedule *Schedule) DisableSchedule(id int32) (schemaResponse ScheduleResponseSingleSchema, err error) {
+	return schedule.patchSchedule(id, ScheduleEnabledRequestSchema{Enabled: false})
+}
+
+// patchSchedule patches a schedule by ID
+//
+//	:param id: The ID of the schedule to patch
+//	:param request: The request to send
+func (schedule *Schedule) patchSchedule(id int32, request any) (schemaResponse ScheduleResponseSingleSchema, err error) {
+	schemaResponse = ScheduleResponseSingleSchema{}
+
+	uri := fmt.Sprintf("%s%d/", schedule.URI, id)
+
+	data, err := json.Marshal(request)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	response, err := schedule.connection.Patch(uri, data)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = schedule.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// PreviewSchedule gets the next occurrences of an rrule as computed by the server
+//
+//	:param rrule: The rrule to preview
+func (schedule *Schedule) PreviewSchedule(rrule string) (schemaResponse SchedulePreviewResponseSchema, err error) {
+	schemaResponse = SchedulePreviewResponseSchema{}
+
+	uri := fmt.Sprintf("%spreview/", schedule.URI)
+
+	data, err := json.Marshal(SchedulePreviewRequestSchema{Rrule: rrule})
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	response, err := schedule.connection.Post(uri, data)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = schedule.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// NextOccurrences gets up to count next occurrences of an rrule in UTC, the server previews at most 10
+//
+//	:param rrule: The rrule to preview
+//	:param count: The maximum number of occurrences to get
+func (schedule *Schedule) NextOccurrences(rrule string, count int) (occurrences []time.Time, err error) {
+	occurrences = []time.Time{}
+
+	preview, err := schedule.PreviewSchedule(rrule)
+
+	if err != nil {
+		return occurrences, err
+	}
+
+	for _, utc := range preview.UTC {
+		if len(occurrences) >= count {
+			break
+		}
+
+		occurrence, err := time.Parse(time.RFC3339, utc)
+
+		if err != nil {
+			return occurrences, fmt.Errorf("invalid occurrence %q in schedule preview: %w", utc, err)
+		}
+
+		occurrences = append(occurrences, occurrence)
+	}
+
+	return occurrences, nil
+}
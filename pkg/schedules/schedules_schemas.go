@@ -0,0 +1,102 @@
+package schedules
+
+// ScheduleRequestSchema is the schema for a schedule request
+//
+// The prompts are only sent when they are set and are only accepted when the template asks for them on launch.
+type ScheduleRequestSchema struct {
+	Name        string         `json:"name" yaml:"name"`
+	Description string         `json:"description" yaml:"description"`
+	Rrule       string         `json:"rrule" yaml:"rrule"`
+	Enabled     bool           `json:"enabled" yaml:"enabled"`
+	ExtraData   map[string]any `json:"extra_data,omitempty" yaml:"extra_data,omitempty"`
+	Inventory   int32          `json:"inventory,omitempty" yaml:"inventory,omitempty"`
+	ScmBranch   string         `json:"scm_branch,omitempty" yaml:"scm_branch,omitempty"`
+	JobType     string         `json:"job_type,omitempty" yaml:"job_type,omitempty"`
+	JobTags     string         `json:"job_tags,omitempty" yaml:"job_tags,omitempty"`
+	SkipTags    string         `json:"skip_tags,omitempty" yaml:"skip_tags,omitempty"`
+	Limit       string         `json:"limit,omitempty" yaml:"limit,omitempty"`
+	Verbosity   int32          `json:"verbosity,omitempty" yaml:"verbosity,omitempty"`
+}
+
+// ScheduleUpdateRequestSchema is the schema for a schedule update request, only the fields that are set are sent so
+// the others keep their current value
+type ScheduleUpdateRequestSchema struct {
+	Name        *string        `json:"name,omitempty" yaml:"name,omitempty"`
+	Description *string        `json:"description,omitempty" yaml:"description,omitempty"`
+	Rrule       *string        `json:"rrule,omitempty" yaml:"rrule,omitempty"`
+	Enabled     *bool          `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	ExtraData   map[string]any `json:"extra_data,omitempty" yaml:"extra_data,omitempty"`
+	Inventory   *int32         `json:"inventory,omitempty" yaml:"inventory,omitempty"`
+	ScmBranch   *string        `json:"scm_branch,omitempty" yaml:"scm_branch,omitempty"`
+	JobType     *string        `json:"job_type,omitempty" yaml:"job_type,omitempty"`
+	JobTags     *string        `json:"job_tags,omitempty" yaml:"job_tags,omitempty"`
+	SkipTags    *string        `json:"skip_tags,omitempty" yaml:"skip_tags,omitempty"`
+	Limit       *string        `json:"limit,omitempty" yaml:"limit,omitempty"`
+	Verbosity   *int32         `json:"verbosity,omitempty" yaml:"verbosity,omitempty"`
+}
+
+// ScheduleEnabledRequestSchema is the schema for a request to enable or disable a schedule
+type ScheduleEnabledRequestSchema struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+}
+
+// ScheduleRelatedResponseSchema is the schema for the related section of a response
+type ScheduleRelatedResponseSchema struct {
+	CreatedBy          string `json:"created_by" yaml:"created_by"`
+	ModifiedBy         string `json:"modified_by" yaml:"modified_by"`
+	UnifiedJobTemplate string `json:"unified_job_template" yaml:"unified_job_template"`
+	UnifiedJobs        string `json:"unified_jobs" yaml:"unified_jobs"`
+	Credentials        string `json:"credentials" yaml:"credentials"`
+	Labels             string `json:"labels" yaml:"labels"`
+	Inventory          string `json:"inventory" yaml:"inventory"`
+}
+
+// ScheduleTemplateSummarySchema is the schema for the summary of the unified job template of a schedule
+type ScheduleTemplateSummarySchema struct {
+	ID             int32  `json:"id" yaml:"id"`
+	Name           string `json:"name" yaml:"name"`
+	Description    string `json:"description" yaml:"description"`
+	UnifiedJobType string `json:"unified_job_type" yaml:"unified_job_type"`
+}
+
+// ScheduleSummaryFieldsSchema is the schema for the summary fields of a schedule
+type ScheduleSummaryFieldsSchema struct {
+	UnifiedJobTemplate ScheduleTemplateSummarySchema `json:"unified_job_template" yaml:"unified_job_template"`
+}
+
+// ScheduleResponseSingleSchema is the schema for a single schedule response item
+type ScheduleResponseSingleSchema struct {
+	ID            int32                         `json:"id" yaml:"id"`
+	Type          string                        `json:"type" yaml:"type"`
+	URL           string                        `json:"url" yaml:"url"`
+	Related       ScheduleRelatedResponseSchema `json:"related" yaml:"related"`
+	SummaryFields ScheduleSummaryFieldsSchema   `json:"summary_fields" yaml:"summary_fields"`
+	ScheduleRequestSchema
+	Created            string `json:"created" yaml:"created"`
+	Modified           string `json:"modified" yaml:"modified"`
+	UnifiedJobTemplate int32  `json:"unified_job_template" yaml:"unified_job_template"`
+	Dtstart            string `json:"dtstart" yaml:"dtstart"`
+	Dtend              string `json:"dtend" yaml:"dtend"`
+	NextRun            string `json:"next_run" yaml:"next_run"`
+	Timezone           string `json:"timezone" yaml:"timezone"`
+	Until              string `json:"until" yaml:"until"`
+}
+
+// ScheduleResponseSchema is the schema for a schedule response
+type ScheduleResponseSchema struct {
+	Count    int32                          `json:"count" yaml:"count"`
+	Next     string                         `json:"next" yaml:"next"`
+	Previous string                         `json:"previous" yaml:"previous"`
+	Results  []ScheduleResponseSingleSchema `json:"results" yaml:"results"`
+}
+
+// SchedulePreviewRequestSchema is the schema for a schedule preview request
+type SchedulePreviewRequestSchema struct {
+	Rrule string `json:"rrule" yaml:"rrule"`
+}
+
+// SchedulePreviewResponseSchema is the schema for a schedule preview response, the occurrences are RFC 3339 timestamps
+type SchedulePreviewResponseSchema struct {
+	Local []string `json:"local" yaml:"local"`
+	UTC   []string `json:"utc" yaml:"utc"`
+}
@@ -0,0 +1,55 @@
+package schedules
+
+import (
+	"github.com/btr1975/go-ansible-aap-api-client/internal/fakeconnection"
+	"net/http"
+	"testing"
+)
+
+func TestSchedule_UpdateSchedule(t *testing.T) {
+	rrule := "DTSTART:20260101T020000Z RRULE:FREQ=DAILY;INTERVAL=1"
+	enabled := false
+
+	tests := []struct {
+		name   string
+		update func(schedule *Schedule) (ScheduleResponseSingleSchema, error)
+		want   string
+	}{
+		{
+			name: "Test UpdateSchedule sends only the set fields",
+			update: func(schedule *Schedule) (ScheduleResponseSingleSchema, error) {
+				return schedule.UpdateSchedule(6, ScheduleUpdateRequestSchema{Rrule: &rrule})
+			},
+			want: `{"rrule":"DTSTART:20260101T020000Z RRULE:FREQ=DAILY;INTERVAL=1"}`,
+		},
+		{
+			name: "Test UpdateSchedule disable",
+			update: func(schedule *Schedule) (ScheduleResponseSingleSchema, error) {
+				return schedule.UpdateSchedule(6, ScheduleUpdateRequestSchema{Enabled: &enabled})
+			},
+			want: `{"enabled":false}`,
+		},
+		{
+			name: "Test EnableSchedule",
+			update: func(schedule *Schedule) (ScheduleResponseSingleSchema, error) {
+				return schedule.EnableSchedule(6)
+			},
+			want: `{"enabled":true}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			connection := fakeconnection.New().HandleJSON(http.MethodPatch, "schedules/6/", ScheduleResponseSingleSchema{ID: 6})
+
+			response, err := tt.update(NewSchedule(connection))
+
+			if err != nil || response.ID != 6 {
+				t.Fatalf("Schedule update = %+v, err = %v", response, err)
+			}
+
+			if got := string(connection.Requests()[0].Data); got != tt.want {
+				t.Errorf("Schedule update sent %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
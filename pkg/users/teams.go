@@ -0,0 +1,289 @@
+package users
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/common"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/connection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/dataconversion"
+	"slices"
+	"strconv"
+)
+
+// Team represents an AAP team
+type Team struct {
+	URI            string
+	UserURI        string
+	connection     connection.BasicConnection
+	DataConversion dataconversion.DataConverterInterface
+}
+
+// NewTeam creates a new team instance
+//
+//	:param basicConnection: The basic connection to use
+func NewTeam(basicConnection connection.BasicConnection) *Team {
+	return &Team{
+		URI:            "teams/",
+		UserURI:        "users/",
+		connection:     basicConnection,
+		DataConversion: dataconversion.NewDataConverter(),
+	}
+}
+
+// GetAllTeams gets all teams
+func (team *Team) GetAllTeams() (schemaResponse TeamResponseSchema, err error) {
+	schemaResponse = TeamResponseSchema{}
+
+	response, err := team.connection.Get(team.URI, nil)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = team.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// GetTeam gets a team by name within an organization
+//
+//	:param name: The name of the team to get
+//	:param organizationID: The ID of the organization of the team
+func (team *Team) GetTeam(name string, organizationID int32) (schemaResponse TeamResponseSchema, err error) {
+	schemaResponse = TeamResponseSchema{}
+
+	params := map[string]string{
+		"name":         name,
+		"organization": strconv.Itoa(int(organizationID)),
+	}
+
+	response, err := team.connection.Get(team.URI, params)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = team.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// GetTeamID gets a team ID by name within an organization
+//
+//	:param name: The name of the team to get
+//	:param organizationID: The ID of the organization of the team
+func (team *Team) GetTeamID(name string, organizationID int32) (id int32, err error) {
+	schemaResponse, err := team.GetTeam(name, organizationID)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if len(schemaResponse.Results) == 0 {
+		return 0, fmt.Errorf("no team found with name %s in organization %d", name, organizationID)
+	}
+
+	return schemaResponse.Results[0].ID, nil
+}
+
+// GetTeamByID gets a team by ID
+//
+//	:param id: The ID of the team to get
+func (team *Team) GetTeamByID(id int32) (schemaResponse TeamResponseSingleSchema, err error) {
+	schemaResponse = TeamResponseSingleSchema{}
+
+	uri := fmt.Sprintf("%s%d/", team.URI, id)
+
+	response, err := team.connection.Get(uri, nil)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = team.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// CreateTeam creates a new team
+//
+//	:param teamRequest: The team request schema to use
+func (team *Team) CreateTeam(teamRequest TeamRequestSchema) (schemaResponse TeamResponseSingleSchema, err error) {
+	schemaResponse = TeamResponseSingleSchema{}
+
+	data, err := json.Marshal(teamRequest)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	response, err := team.connection.Post(team.URI, data)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = team.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// UpdateTeam updates a team by ID
+//
+//	:param id: The ID of the team to update
+//	:param teamRequest: The team request schema to use
+func (team *Team) UpdateTeam(id int32, teamRequest TeamRequestSchema) (schemaResponse TeamResponseSingleSchema, err error) {
+	schemaResponse = TeamResponseSingleSchema{}
+
+	uri := fmt.Sprintf("%s%d/", team.URI, id)
+
+	data, err := json.Marshal(teamRequest)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	response, err := team.connection.Patch(uri, data)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = team.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// DeleteTeam deletes a team by ID
+//
+//	:param id: The ID of the team to delete
+func (team *Team) DeleteTeam(id int32) (statusCode int, err error) {
+	uri := fmt.Sprintf("%s%d/", team.URI, id)
+
+	response, err := team.connection.Delete(uri, nil)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response.StatusCode, nil
+}
+
+// GetTeamMembers gets the members of a team by ID
+//
+//	:param id: The ID of the team
+func (team *Team) GetTeamMembers(id int32) (members []UserResponseSingleSchema, err error) {
+	return common.GetAllPages[UserResponseSingleSchema](team.connection, team.DataConversion, fmt.Sprintf("%s%d/users/", team.URI, id), nil)
+}
+
+// AddTeamMember adds a user to a team
+//
+//	:param id: The ID of the team
+//	:param userID: The ID of the user to add
+func (team *Team) AddTeamMember(id int32, userID int32) (statusCode int, err error) {
+	return team.associate(id, userID, false)
+}
+
+// RemoveTeamMember removes a user from a team
+//
+//	:param id: The ID of the team
+//	:param userID: The ID of the user to remove
+func (team *Team) RemoveTeamMember(id int32, userID int32) (statusCode int, err error) {
+	return team.associate(id, userID, true)
+}
+
+// associate associates or disassociates a user on the users endpoint of a team
+//
+//	:param id: The ID of the team
+//	:param userID: The ID of the user
+//	:param disassociate: Whether to disassociate instead of associate
+func (team *Team) associate(id int32, userID int32, disassociate bool) (statusCode int, err error) {
+	uri := fmt.Sprintf("%s%d/users/", team.URI, id)
+
+	return common.Associate(team.connection, uri, userID, disassociate)
+}
+
+// SyncTeamMembers makes the members of a team exactly the given usernames, for example the members of an LDAP group
+//
+// Usernames that do not exist in AAP are reported as missing instead of failing the sync.
+//
+//	:param id: The ID of the team
+//	:param usernames: The usernames the team should have as members
+func (team *Team) SyncTeamMembers(id int32, usernames []string) (report MembershipSyncReportSchema, err error) {
+	report = MembershipSyncReportSchema{Added: []string{}, Removed: []string{}, Missing: []string{}}
+
+	members, err := team.GetTeamMembers(id)
+
+	if err != nil {
+		return report, err
+	}
+
+	current := map[string]int32{}
+
+	for _, member := range members {
+		current[member.Username] = member.ID
+	}
+
+	wanted := slices.Clone(usernames)
+	slices.Sort(wanted)
+	wanted = slices.Compact(wanted)
+
+	for _, username := range wanted {
+		if _, ok := current[username]; ok {
+			continue
+		}
+
+		found, err := common.GetAllPages[UserResponseSingleSchema](team.connection, team.DataConversion, team.UserURI, map[string]string{"username": username})
+
+		if err != nil {
+			return report, err
+		}
+
+		if len(found) == 0 {
+			report.Missing = append(report.Missing, username)
+			continue
+		}
+
+		if _, err = team.AddTeamMember(id, found[0].ID); err != nil {
+			return report, fmt.Errorf("error adding %s to team %d: %w", username, id, err)
+		}
+
+		report.Added = append(report.Added, username)
+	}
+
+	for _, member := range members {
+		if _, ok := slices.BinarySearch(wanted, member.Username); ok {
+			continue
+		}
+
+		if _, err = team.RemoveTeamMember(id, member.ID); err != nil {
+			return report, fmt.Errorf("error removing %s from team %d: %w", member.Username, id, err)
+		}
+
+		report.Removed = append(report.Removed, member.Username)
+	}
+
+	slices.Sort(report.Removed)
+
+	return report, nil
+}
@@ -0,0 +1,97 @@
+package users
+
+import (
+	"github.com/btr1975/go-ansible-aap-api-client/internal/fakeconnection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/common"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+// fakeTeamServer keeps the users and the members of team 9 in memory
+type fakeTeamServer struct {
+	*fakeconnection.Connection
+	users   map[string]int32
+	members []int32
+}
+
+func newFakeTeamServer(userIDs map[string]int32, members ...int32) *fakeTeamServer {
+	server := &fakeTeamServer{Connection: fakeconnection.New(), users: userIDs, members: members}
+
+	server.Handle(http.MethodGet, "users/", server.getUsers).
+		Handle(http.MethodGet, "teams/9/users/", server.getMembers).
+		Handle(http.MethodPost, "teams/9/users/", server.associate)
+
+	return server
+}
+
+func (server *fakeTeamServer) user(id int32) UserResponseSingleSchema {
+	for username, userID := range server.users {
+		if userID == id {
+			return UserResponseSingleSchema{ID: id, UserRequestSchema: UserRequestSchema{Username: username}}
+		}
+	}
+
+	return UserResponseSingleSchema{ID: id}
+}
+
+func (server *fakeTeamServer) getUsers(request fakeconnection.Request) (*http.Response, error) {
+	results := []UserResponseSingleSchema{}
+
+	if id, ok := server.users[request.Params["username"]]; ok {
+		results = append(results, server.user(id))
+	}
+
+	return fakeconnection.JSON(UserResponseSchema{Count: int32(len(results)), Results: results})
+}
+
+func (server *fakeTeamServer) getMembers(fakeconnection.Request) (*http.Response, error) {
+	results := []UserResponseSingleSchema{}
+
+	for _, id := range server.members {
+		results = append(results, server.user(id))
+	}
+
+	return fakeconnection.JSON(UserResponseSchema{Count: int32(len(results)), Results: results})
+}
+
+func (server *fakeTeamServer) associate(request fakeconnection.Request) (*http.Response, error) {
+	association := common.AssociationRequestSchema{}
+	_ = request.Decode(&association)
+
+	if association.Disassociate {
+		server.members = slices.DeleteFunc(server.members, func(id int32) bool { return id == association.ID })
+	} else {
+		server.members = append(server.members, association.ID)
+	}
+
+	return fakeconnection.NoContent()
+}
+
+func TestTeam_SyncTeamMembers(t *testing.T) {
+	connection := newFakeTeamServer(map[string]int32{"alice": 1, "bob": 2, "carol": 3, "dave": 4}, 1, 4, 2)
+	team := NewTeam(connection)
+
+	report, err := team.SyncTeamMembers(9, []string{"carol", "alice", "erin", "carol"})
+
+	if err != nil {
+		t.Fatalf("Team.SyncTeamMembers() error = %v", err)
+	}
+
+	if !slices.Equal(report.Added, []string{"carol"}) || !slices.Equal(report.Removed, []string{"bob", "dave"}) || !slices.Equal(report.Missing, []string{"erin"}) {
+		t.Errorf("Team.SyncTeamMembers() report = %+v", report)
+	}
+
+	members := slices.Clone(connection.members)
+	slices.Sort(members)
+
+	if !slices.Equal(members, []int32{1, 3}) {
+		t.Errorf("Team.SyncTeamMembers() members = %v, want [1 3]", members)
+	}
+
+	report, err = team.SyncTeamMembers(9, []string{"alice", "carol"})
+
+	if err != nil || len(report.Added)+len(report.Removed)+len(report.Missing) != 0 {
+		t.Errorf("Team.SyncTeamMembers() second run report = %+v, err = %v, want no changes", report, err)
+	}
+}
@@ -0,0 +1,233 @@
+/*
+Package users provides a way to manipulate users, teams and team membership for Ansible AAP
+*/
+package users
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/common"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/connection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/dataconversion"
+)
+
+// User represents an AAP user
+type User struct {
+	URI            string
+	MeURI          string
+	connection     connection.BasicConnection
+	DataConversion dataconversion.DataConverterInterface
+}
+
+// NewUser creates a new user instance
+//
+//	:param basicConnection: The basic connection to use
+func NewUser(basicConnection connection.BasicConnection) *User {
+	return &User{
+		URI:            "users/",
+		MeURI:          "me/",
+		connection:     basicConnection,
+		DataConversion: dataconversion.NewDataConverter(),
+	}
+}
+
+// GetAllUsers gets every user, following the pages of the response
+func (user *User) GetAllUsers() (users []UserResponseSingleSchema, err error) {
+	return common.GetAllPages[UserResponseSingleSchema](user.connection, user.DataConversion, user.URI, nil)
+}
+
+// GetUser gets a user by username
+//
+//	:param username: The username of the user to get
+func (user *User) GetUser(username string) (schemaResponse UserResponseSchema, err error) {
+	schemaResponse = UserResponseSchema{}
+
+	params := map[string]string{
+		"username": username,
+	}
+
+	response, err := user.connection.Get(user.URI, params)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = user.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// GetUserID gets a user ID by username
+//
+//	:param username: The username of the user to get
+func (user *User) GetUserID(username string) (id int32, err error) {
+	schemaResponse, err := user.GetUser(username)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if len(schemaResponse.Results) == 0 {
+		return 0, fmt.Errorf("no user found with username %s", username)
+	}
+
+	return schemaResponse.Results[0].ID, nil
+}
+
+// GetUserByID gets a user by ID
+//
+//	:param id: The ID of the user to get
+func (user *User) GetUserByID(id int32) (schemaResponse UserResponseSingleSchema, err error) {
+	schemaResponse = UserResponseSingleSchema{}
+
+	uri := fmt.Sprintf("%s%d/", user.URI, id)
+
+	response, err := user.connection.Get(uri, nil)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = user.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// GetMe gets the user the connection is authenticated as
+func (user *User) GetMe() (schemaResponse UserResponseSingleSchema, err error) {
+	schemaResponse = UserResponseSingleSchema{}
+
+	response, err := user.connection.Get(user.MeURI, nil)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	meResponse := UserResponseSchema{}
+
+	err = user.DataConversion.ResponseBodyToStruct(&meResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	if len(meResponse.Results) == 0 {
+		return schemaResponse, errors.New("no user found for the current connection")
+	}
+
+	return meResponse.Results[0], nil
+}
+
+// CreateUser creates a new user
+//
+//	:param userRequest: The user request schema to use
+func (user *User) CreateUser(userRequest UserRequestSchema) (schemaResponse UserResponseSingleSchema, err error) {
+	schemaResponse = UserResponseSingleSchema{}
+
+	data, err := json.Marshal(userRequest)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	response, err := user.connection.Post(user.URI, data)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = user.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// UpdateUser updates the fields of a user by ID that are set in the request
+//
+//	:param id: The ID of the user to update
+//	:param userRequest: The user update request schema to use
+func (user *User) UpdateUser(id int32, userRequest UserUpdateRequestSchema) (schemaResponse UserResponseSingleSchema, err error) {
+	return user.patchUser(id, userRequest)
+}
+
+// SetPassword sets the password of a user by ID
+//
+//	:param id: The ID of the user
+//	:param password: The new password
+func (user *User) SetPassword(id int32, password string) (err error) {
+	if password == "" {
+		return errors.New("password can not be empty")
+	}
+
+	_, err = user.patchUser(id, UserPasswordRequestSchema{Password: password})
+
+	return err
+}
+
+// patchUser patches a user by ID
+//
+//	:param id: The ID of the user to patch
+//	:param request: The request to send
+func (user *User) patchUser(id int32, request any) (schemaResponse UserResponseSingleSchema, err error) {
+	schemaResponse = UserResponseSingleSchema{}
+
+	uri := fmt.Sprintf("%s%d/", user.URI, id)
+
+	data, err := json.Marshal(request)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	response, err := user.connection.Patch(uri, data)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = user.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// DeleteUser deletes a user by ID
+//
+//	:param id: The ID of the user to delete
+func (user *User) DeleteUser(id int32) (statusCode int, err error) {
+	uri := fmt.Sprintf("%s%d/", user.URI, id)
+
+	response, err := user.connection.Delete(uri, nil)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer response.Body.Close()
+
+	return response.StatusCode, nil
+}
+
+// GetUserTeams gets the teams of a user by ID
+//
+//	:param id: The ID of the user
+func (user *User) GetUserTeams(id int32) (teams []TeamResponseSingleSchema, err error) {
+	uri := fmt.Sprintf("%s%d/teams/", user.URI, id)
+
+	return common.GetAllPages[TeamResponseSingleSchema](user.connection, user.DataConversion, uri, nil)
+}
@@ -0,0 +1,116 @@
+package users
+
+// UserRequestSchema is the schema for a user request, the password is only sent when it is set
+type UserRequestSchema struct {
+	Username        string `json:"username" yaml:"username"`
+	FirstName       string `json:"first_name" yaml:"first_name"`
+	LastName        string `json:"last_name" yaml:"last_name"`
+	Email           string `json:"email" yaml:"email"`
+	IsSuperuser     bool   `json:"is_superuser" yaml:"is_superuser"`
+	IsSystemAuditor bool   `json:"is_system_auditor" yaml:"is_system_auditor"`
+	Password        string `json:"password,omitempty" yaml:"password,omitempty"`
+}
+
+// UserUpdateRequestSchema is the schema for a user update request, only the fields that are set are sent so the
+// others keep their current value, use User.SetPassword to change the password
+type UserUpdateRequestSchema struct {
+	Username        *string `json:"username,omitempty" yaml:"username,omitempty"`
+	FirstName       *string `json:"first_name,omitempty" yaml:"first_name,omitempty"`
+	LastName        *string `json:"last_name,omitempty" yaml:"last_name,omitempty"`
+	Email           *string `json:"email,omitempty" yaml:"email,omitempty"`
+	IsSuperuser     *bool   `json:"is_superuser,omitempty" yaml:"is_superuser,omitempty"`
+	IsSystemAuditor *bool   `json:"is_system_auditor,omitempty" yaml:"is_system_auditor,omitempty"`
+}
+
+// UserPasswordRequestSchema is the schema for a request to set the password of a user
+type UserPasswordRequestSchema struct {
+	Password string `json:"password" yaml:"password"`
+}
+
+// UserRelatedResponseSchema is the schema for the related section of a response
+type UserRelatedResponseSchema struct {
+	Teams                string `json:"teams" yaml:"teams"`
+	Organizations        string `json:"organizations" yaml:"organizations"`
+	AdminOfOrganizations string `json:"admin_of_organizations" yaml:"admin_of_organizations"`
+	Projects             string `json:"projects" yaml:"projects"`
+	Credentials          string `json:"credentials" yaml:"credentials"`
+	Roles                string `json:"roles" yaml:"roles"`
+	ActivityStream       string `json:"activity_stream" yaml:"activity_stream"`
+	AccessList           string `json:"access_list" yaml:"access_list"`
+	Tokens               string `json:"tokens" yaml:"tokens"`
+	AuthorizedTokens     string `json:"authorized_tokens" yaml:"authorized_tokens"`
+	PersonalTokens       string `json:"personal_tokens" yaml:"personal_tokens"`
+	Applications         string `json:"applications" yaml:"applications"`
+}
+
+// UserResponseSingleSchema is the schema for a single user response item
+type UserResponseSingleSchema struct {
+	ID      int32                     `json:"id" yaml:"id"`
+	Type    string                    `json:"type" yaml:"type"`
+	URL     string                    `json:"url" yaml:"url"`
+	Related UserRelatedResponseSchema `json:"related" yaml:"related"`
+	UserRequestSchema
+	Created         string `json:"created" yaml:"created"`
+	Modified        string `json:"modified" yaml:"modified"`
+	LdapDn          string `json:"ldap_dn" yaml:"ldap_dn"`
+	LastLogin       string `json:"last_login" yaml:"last_login"`
+	ExternalAccount string `json:"external_account" yaml:"external_account"`
+}
+
+// UserResponseSchema is the schema for a user response
+type UserResponseSchema struct {
+	Count    int32                      `json:"count" yaml:"count"`
+	Next     string                     `json:"next" yaml:"next"`
+	Previous string                     `json:"previous" yaml:"previous"`
+	Results  []UserResponseSingleSchema `json:"results" yaml:"results"`
+}
+
+// TeamRequestSchema is the schema for a team request
+type TeamRequestSchema struct {
+	Name         string `json:"name" yaml:"name"`
+	Description  string `json:"description" yaml:"description"`
+	Organization int32  `json:"organization" yaml:"organization"`
+}
+
+// TeamRelatedResponseSchema is the schema for the related section of a response
+type TeamRelatedResponseSchema struct {
+	CreatedBy      string `json:"created_by" yaml:"created_by"`
+	ModifiedBy     string `json:"modified_by" yaml:"modified_by"`
+	Projects       string `json:"projects" yaml:"projects"`
+	Users          string `json:"users" yaml:"users"`
+	Credentials    string `json:"credentials" yaml:"credentials"`
+	Roles          string `json:"roles" yaml:"roles"`
+	ObjectRoles    string `json:"object_roles" yaml:"object_roles"`
+	ActivityStream string `json:"activity_stream" yaml:"activity_stream"`
+	AccessList     string `json:"access_list" yaml:"access_list"`
+	Organization   string `json:"organization" yaml:"organization"`
+}
+
+// TeamResponseSingleSchema is the schema for a single team response item
+type TeamResponseSingleSchema struct {
+	ID      int32                     `json:"id" yaml:"id"`
+	Type    string                    `json:"type" yaml:"type"`
+	URL     string                    `json:"url" yaml:"url"`
+	Related TeamRelatedResponseSchema `json:"related" yaml:"related"`
+	TeamRequestSchema
+	Created  string `json:"created" yaml:"created"`
+	Modified string `json:"modified" yaml:"modified"`
+}
+
+// TeamResponseSchema is the schema for a team response
+type TeamResponseSchema struct {
+	Count    int32                      `json:"count" yaml:"count"`
+	Next     string                     `json:"next" yaml:"next"`
+	Previous string                     `json:"previous" yaml:"previous"`
+	Results  []TeamResponseSingleSchema `json:"results" yaml:"results"`
+}
+
+// MembershipSyncReportSchema is the schema for the report of a team membership sync
+type MembershipSyncReportSchema struct {
+	// Added are the usernames added to the team
+	Added []string `json:"added" yaml:"added"`
+	// Removed are the usernames removed from the team
+	Removed []string `json:"removed" yaml:"removed"`
+	// Missing are the wanted usernames that do not exist in AAP
+	Missing []string `json:"missing" yaml:"missing"`
+}
@@ -0,0 +1,131 @@
+package users
+
+import (
+	"github.com/btr1975/go-ansible-aap-api-client/internal/fakeconnection"
+	"net/http"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// newFakeUserConnection answers with user 4 "alice", who the connection is authenticated as, with the users 1 to 3
+// over three pages and with the create, patch and delete of user 4
+func newFakeUserConnection() *fakeconnection.Connection {
+	alice := UserResponseSingleSchema{ID: 4, UserRequestSchema: UserRequestSchema{Username: "alice", IsSuperuser: true}}
+
+	connection := fakeconnection.New().
+		HandleJSON(http.MethodGet, "me/", UserResponseSchema{Count: 1, Results: []UserResponseSingleSchema{alice}}).
+		HandleJSON(http.MethodPost, "users/", alice).
+		HandleJSON(http.MethodPatch, "users/4/", alice)
+
+	connection.Handle(http.MethodGet, "users/", func(request fakeconnection.Request) (*http.Response, error) {
+		if request.Params["username"] == "alice" {
+			return fakeconnection.JSON(UserResponseSchema{Count: 1, Results: []UserResponseSingleSchema{alice}})
+		}
+
+		if request.Params["username"] != "" {
+			return fakeconnection.JSON(UserResponseSchema{})
+		}
+
+		page, _ := strconv.Atoi(request.Params["page"])
+		response := UserResponseSchema{Count: 3, Results: []UserResponseSingleSchema{{ID: int32(page)}}}
+
+		if page < 3 {
+			response.Next = "next"
+		}
+
+		return fakeconnection.JSON(response)
+	})
+
+	connection.Handle(http.MethodDelete, "users/4/", func(fakeconnection.Request) (*http.Response, error) {
+		return fakeconnection.NoContent()
+	})
+
+	return connection
+}
+
+func TestUser_GetMe(t *testing.T) {
+	me, err := NewUser(newFakeUserConnection()).GetMe()
+
+	if err != nil || me.ID != 4 || me.Username != "alice" {
+		t.Errorf("User.GetMe() = %+v, err = %v, want alice", me, err)
+	}
+
+	if _, err = NewUser(fakeconnection.New().HandleJSON(http.MethodGet, "me/", UserResponseSchema{})).GetMe(); err == nil {
+		t.Errorf("User.GetMe() error = nil, want no user error")
+	}
+}
+
+func TestUser_GetAllUsers(t *testing.T) {
+	users, err := NewUser(newFakeUserConnection()).GetAllUsers()
+
+	if err != nil {
+		t.Fatalf("User.GetAllUsers() error = %v", err)
+	}
+
+	got := []int32{}
+
+	for _, user := range users {
+		got = append(got, user.ID)
+	}
+
+	if !slices.Equal(got, []int32{1, 2, 3}) {
+		t.Errorf("User.GetAllUsers() = %v, want the users of every page", got)
+	}
+}
+
+func TestUser_GetUserID(t *testing.T) {
+	user := NewUser(newFakeUserConnection())
+
+	if id, err := user.GetUserID("alice"); err != nil || id != 4 {
+		t.Errorf("User.GetUserID(alice) = %d, %v, want 4", id, err)
+	}
+
+	if _, err := user.GetUserID("mallory"); err == nil {
+		t.Errorf("User.GetUserID(mallory) error = nil, want no user error")
+	}
+}
+
+func TestUser_CreateUpdateDelete(t *testing.T) {
+	connection := newFakeUserConnection()
+	user := NewUser(connection)
+
+	if _, err := user.CreateUser(UserRequestSchema{Username: "alice", IsSuperuser: true}); err != nil {
+		t.Fatalf("User.CreateUser() error = %v", err)
+	}
+
+	email := "alice@example.com"
+
+	if _, err := user.UpdateUser(4, UserUpdateRequestSchema{Email: &email}); err != nil {
+		t.Fatalf("User.UpdateUser() error = %v", err)
+	}
+
+	if err := user.SetPassword(4, "secret"); err != nil {
+		t.Fatalf("User.SetPassword() error = %v", err)
+	}
+
+	if err := user.SetPassword(4, ""); err == nil {
+		t.Errorf("User.SetPassword() error = nil, want empty password error")
+	}
+
+	if statusCode, err := user.DeleteUser(4); err != nil || statusCode != http.StatusNoContent {
+		t.Errorf("User.DeleteUser() = %d, %v, want 204", statusCode, err)
+	}
+
+	want := []string{
+		`POST users/ {"username":"alice","first_name":"","last_name":"","email":"","is_superuser":true,"is_system_auditor":false}`,
+		`PATCH users/4/ {"email":"alice@example.com"}`,
+		`PATCH users/4/ {"password":"secret"}`,
+		`DELETE users/4/ `,
+	}
+
+	got := []string{}
+
+	for _, request := range connection.Requests() {
+		got = append(got, request.String()+" "+string(request.Data))
+	}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("User requests = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,311 @@
+package websocket
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// Websocket opcodes from RFC 6455
+const (
+	opContinuation = 0x0
+	opText         = 0x1
+	opBinary       = 0x2
+	opClose        = 0x8
+	opPing         = 0x9
+	opPong         = 0xa
+)
+
+// acceptGUID is the GUID appended to the handshake key to compute the accept key
+const acceptGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// maxMessageSize is the largest message accepted from the server
+const maxMessageSize = 16 << 20
+
+// errClosed is returned when the server closes the websocket
+var errClosed = errors.New("websocket closed by server")
+
+// wsConn is a minimal client side websocket connection
+type wsConn struct {
+	conn       net.Conn
+	reader     *bufio.Reader
+	writeMutex sync.Mutex
+}
+
+// acceptKey computes the Sec-WebSocket-Accept value for a handshake key
+//
+//	:param key: The Sec-WebSocket-Key sent in the handshake
+func acceptKey(key string) string {
+	hash := sha1.Sum([]byte(key + acceptGUID))
+
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
+
+// dial opens a websocket connection
+//
+//	:param ctx: The context used for the handshake
+//	:param wsURL: The ws or wss URL to connect to
+//	:param header: The extra headers to send in the handshake
+//	:param tlsConfig: The TLS config used for wss URLs
+func dial(ctx context.Context, wsURL *url.URL, header http.Header, tlsConfig *tls.Config) (*wsConn, error) {
+	httpURL := *wsURL
+	host := wsURL.Host
+
+	switch wsURL.Scheme {
+	case "ws":
+		httpURL.Scheme = "http"
+		if wsURL.Port() == "" {
+			host = net.JoinHostPort(wsURL.Hostname(), "80")
+		}
+	case "wss":
+		httpURL.Scheme = "https"
+		if wsURL.Port() == "" {
+			host = net.JoinHostPort(wsURL.Hostname(), "443")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported websocket scheme: %s", wsURL.Scheme)
+	}
+
+	var conn net.Conn
+	var err error
+
+	if wsURL.Scheme == "wss" {
+		config := &tls.Config{}
+
+		if tlsConfig != nil {
+			config = tlsConfig.Clone()
+		}
+
+		if config.ServerName == "" {
+			config.ServerName = wsURL.Hostname()
+		}
+
+		dialer := &tls.Dialer{Config: config}
+		conn, err = dialer.DialContext(ctx, "tcp", host)
+	} else {
+		dialer := &net.Dialer{}
+		conn, err = dialer.DialContext(ctx, "tcp", host)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	keyBytes := make([]byte, 16)
+	_, _ = rand.Read(keyBytes)
+	key := base64.StdEncoding.EncodeToString(keyBytes)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, httpURL.String(), nil)
+
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	for name, values := range header {
+		request.Header[name] = values
+	}
+
+	request.Header.Set("Upgrade", "websocket")
+	request.Header.Set("Connection", "Upgrade")
+	request.Header.Set("Sec-WebSocket-Key", key)
+	request.Header.Set("Sec-WebSocket-Version", "13")
+
+	err = request.Write(conn)
+
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	reader := bufio.NewReader(conn)
+
+	response, err := http.ReadResponse(reader, request)
+
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusSwitchingProtocols {
+		body, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		response.Body.Close()
+		conn.Close()
+		return nil, fmt.Errorf("error websocket handshake response code %d, detail: %s", response.StatusCode, string(body))
+	}
+
+	if response.Header.Get("Sec-WebSocket-Accept") != acceptKey(key) {
+		conn.Close()
+		return nil, errors.New("error websocket handshake, invalid Sec-WebSocket-Accept")
+	}
+
+	_ = conn.SetDeadline(time.Time{})
+
+	return &wsConn{conn: conn, reader: reader}, nil
+}
+
+// writeFrame writes a single masked frame
+//
+//	:param opcode: The opcode of the frame
+//	:param payload: The payload of the frame
+func (ws *wsConn) writeFrame(opcode byte, payload []byte) error {
+	ws.writeMutex.Lock()
+	defer ws.writeMutex.Unlock()
+
+	frame := []byte{0x80 | opcode}
+	length := len(payload)
+
+	switch {
+	case length < 126:
+		frame = append(frame, 0x80|byte(length))
+	case length <= 0xffff:
+		frame = append(frame, 0x80|126)
+		frame = binary.BigEndian.AppendUint16(frame, uint16(length))
+	default:
+		frame = append(frame, 0x80|127)
+		frame = binary.BigEndian.AppendUint64(frame, uint64(length))
+	}
+
+	mask := make([]byte, 4)
+	_, _ = rand.Read(mask)
+	frame = append(frame, mask...)
+
+	for i, b := range payload {
+		frame = append(frame, b^mask[i%4])
+	}
+
+	_, err := ws.conn.Write(frame)
+
+	return err
+}
+
+// writeText writes a text message
+//
+//	:param payload: The message to write
+func (ws *wsConn) writeText(payload []byte) error {
+	return ws.writeFrame(opText, payload)
+}
+
+// readFrame reads a single frame
+func (ws *wsConn) readFrame() (fin bool, opcode byte, payload []byte, err error) {
+	header := make([]byte, 2)
+
+	_, err = io.ReadFull(ws.reader, header)
+
+	if err != nil {
+		return false, 0, nil, err
+	}
+
+	fin = header[0]&0x80 != 0
+	opcode = header[0] & 0x0f
+	masked := header[1]&0x80 != 0
+	length := uint64(header[1] & 0x7f)
+
+	switch length {
+	case 126:
+		extended := make([]byte, 2)
+		if _, err = io.ReadFull(ws.reader, extended); err != nil {
+			return false, 0, nil, err
+		}
+		length = uint64(binary.BigEndian.Uint16(extended))
+	case 127:
+		extended := make([]byte, 8)
+		if _, err = io.ReadFull(ws.reader, extended); err != nil {
+			return false, 0, nil, err
+		}
+		length = binary.BigEndian.Uint64(extended)
+	}
+
+	if length > maxMessageSize {
+		return false, 0, nil, fmt.Errorf("websocket frame of %d bytes is too large", length)
+	}
+
+	mask := make([]byte, 4)
+
+	if masked {
+		if _, err = io.ReadFull(ws.reader, mask); err != nil {
+			return false, 0, nil, err
+		}
+	}
+
+	payload = make([]byte, length)
+
+	if _, err = io.ReadFull(ws.reader, payload); err != nil {
+		return false, 0, nil, err
+	}
+
+	if masked {
+		for i := range payload {
+			payload[i] ^= mask[i%4]
+		}
+	}
+
+	return fin, opcode, payload, nil
+}
+
+// readMessage reads the next text or binary message, answering pings and close frames on the way
+func (ws *wsConn) readMessage() (message []byte, err error) {
+	var messageOpcode byte = 0xff
+
+	for {
+		fin, opcode, payload, err := ws.readFrame()
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch opcode {
+		case opPing:
+			if err = ws.writeFrame(opPong, payload); err != nil {
+				return nil, err
+			}
+			continue
+		case opPong:
+			continue
+		case opClose:
+			_ = ws.writeFrame(opClose, payload)
+			return nil, errClosed
+		case opText, opBinary:
+			messageOpcode = opcode
+			message = payload
+		case opContinuation:
+			if messageOpcode == 0xff {
+				return nil, errors.New("unexpected websocket continuation frame")
+			}
+			message = append(message, payload...)
+		default:
+			return nil, fmt.Errorf("unexpected websocket opcode %d", opcode)
+		}
+
+		if len(message) > maxMessageSize {
+			return nil, fmt.Errorf("websocket message of %d bytes is too large", len(message))
+		}
+
+		if fin {
+			return message, nil
+		}
+	}
+}
+
+// close sends a normal close frame and closes the connection
+func (ws *wsConn) close() error {
+	_ = ws.writeFrame(opClose, []byte{0x03, 0xe8})
+
+	return ws.conn.Close()
+}
@@ -0,0 +1,422 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/jobs"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Subscription delivers the status changes and events of the subscribed jobs
+type Subscription struct {
+	// Statuses receives the status changes of the subscribed jobs
+	Statuses <-chan StatusChangedMessageSchema
+	// Events receives the events of the subscribed jobs, each event once
+	Events <-chan jobs.JobEventResponseSingleSchema
+	// Errors receives the errors that caused a reconnect or the fallback to polling
+	Errors   <-chan error
+	client   *Client
+	statuses chan StatusChangedMessageSchema
+	events   chan jobs.JobEventResponseSingleSchema
+	errs     chan error
+	cancel   context.CancelFunc
+	done     chan struct{}
+	polling  atomic.Bool
+	errMutex sync.Mutex
+	err      error
+	jobs     map[int32]*jobState
+}
+
+// jobState is the delivery state of a subscribed job
+type jobState struct {
+	status         string
+	finished       bool
+	seen           map[int32]bool
+	contiguous     int32
+	eventsComplete bool
+}
+
+// Close stops the subscription and waits for its channels to be closed
+func (subscription *Subscription) Close() {
+	subscription.cancel()
+	<-subscription.done
+}
+
+// Polling gets whether the subscription fell back to polling the REST API
+func (subscription *Subscription) Polling() bool {
+	return subscription.polling.Load()
+}
+
+// Err gets the error that stopped the subscription, nil if it stopped because every job finished
+func (subscription *Subscription) Err() error {
+	subscription.errMutex.Lock()
+	defer subscription.errMutex.Unlock()
+
+	return subscription.err
+}
+
+// setErr sets the error that stopped the subscription
+func (subscription *Subscription) setErr(err error) {
+	subscription.errMutex.Lock()
+	defer subscription.errMutex.Unlock()
+
+	subscription.err = err
+}
+
+// report delivers a non fatal error without blocking
+func (subscription *Subscription) report(err error) {
+	select {
+	case subscription.errs <- err:
+	default:
+	}
+}
+
+// jobIDs gets the IDs of the subscribed jobs
+func (subscription *Subscription) jobIDs() []int32 {
+	ids := make([]int32, 0, len(subscription.jobs))
+
+	for id := range subscription.jobs {
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+// complete gets whether every job is finished and its events are delivered
+func (subscription *Subscription) complete() bool {
+	for _, state := range subscription.jobs {
+		if !state.finished || !state.eventsComplete {
+			return false
+		}
+	}
+
+	return true
+}
+
+// allFinished gets whether every job reached a finished status
+func (subscription *Subscription) allFinished() bool {
+	for _, state := range subscription.jobs {
+		if !state.finished {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sleep waits for a duration or until the context is done
+func sleep(ctx context.Context, duration time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(duration):
+		return true
+	}
+}
+
+// run streams the jobs until they are complete, reconnecting and falling back to polling when needed
+func (subscription *Subscription) run(ctx context.Context) {
+	defer close(subscription.done)
+	defer close(subscription.errs)
+	defer close(subscription.events)
+	defer close(subscription.statuses)
+	defer subscription.cancel()
+
+	client := subscription.client
+	failures := 0
+
+	for ctx.Err() == nil {
+		if failures > client.MaxReconnects {
+			subscription.polling.Store(true)
+			subscription.setErr(subscription.poll(ctx))
+			return
+		}
+
+		connected, err := subscription.stream(ctx)
+
+		if err == nil || ctx.Err() != nil {
+			return
+		}
+
+		if connected {
+			failures = 0
+		} else {
+			failures++
+		}
+
+		subscription.report(err)
+
+		if !sleep(ctx, client.ReconnectInterval) {
+			return
+		}
+	}
+}
+
+// stream connects to the websocket and delivers messages until every job is complete
+func (subscription *Subscription) stream(ctx context.Context) (connected bool, err error) {
+	ws, err := subscription.client.connect(ctx, subscription.jobIDs())
+
+	if err != nil {
+		return false, err
+	}
+
+	stop := make(chan struct{})
+	defer close(stop)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-stop:
+		}
+
+		_ = ws.close()
+	}()
+
+	err = subscription.catchUp(ctx)
+
+	if err != nil {
+		return true, err
+	}
+
+	for !subscription.complete() {
+		if subscription.allFinished() {
+			return true, subscription.finish(ctx)
+		}
+
+		message, err := ws.readMessage()
+
+		if err != nil {
+			return true, err
+		}
+
+		err = subscription.dispatch(ctx, message)
+
+		if err != nil {
+			return true, err
+		}
+	}
+
+	return true, nil
+}
+
+// dispatch delivers a websocket message to the channel it belongs to
+func (subscription *Subscription) dispatch(ctx context.Context, message []byte) error {
+	envelope := struct {
+		GroupName string `json:"group_name"`
+	}{}
+
+	if err := json.Unmarshal(message, &envelope); err != nil {
+		return nil
+	}
+
+	switch {
+	case envelope.GroupName == "jobs":
+		status := StatusChangedMessageSchema{}
+
+		if err := json.Unmarshal(message, &status); err != nil {
+			return nil
+		}
+
+		if _, ok := subscription.jobs[status.UnifiedJobID]; ok {
+			return subscription.setStatus(ctx, status.UnifiedJobID, status.Status)
+		}
+	case strings.HasPrefix(envelope.GroupName, "job_events-"):
+		event := jobs.JobEventResponseSingleSchema{}
+
+		if err := json.Unmarshal(message, &event); err != nil {
+			return nil
+		}
+
+		return subscription.deliverEvent(ctx, event)
+	}
+
+	return nil
+}
+
+// setStatus delivers a status change of a job when it differs from the last delivered status
+func (subscription *Subscription) setStatus(ctx context.Context, id int32, status string) error {
+	state := subscription.jobs[id]
+
+	if status == "" || status == state.status {
+		return nil
+	}
+
+	state.status = status
+	state.finished = jobs.IsFinishedStatus(status)
+
+	select {
+	case subscription.statuses <- StatusChangedMessageSchema{GroupName: "jobs", Type: "job", UnifiedJobID: id, Status: status}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// deliverEvent delivers an event of a subscribed job that was not delivered before
+func (subscription *Subscription) deliverEvent(ctx context.Context, event jobs.JobEventResponseSingleSchema) error {
+	state, ok := subscription.jobs[event.Job]
+
+	if !ok || state.seen[event.Counter] {
+		return nil
+	}
+
+	select {
+	case subscription.events <- event:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	state.seen[event.Counter] = true
+
+	for state.seen[state.contiguous+1] {
+		state.contiguous++
+	}
+
+	return nil
+}
+
+// backfill delivers the events of a job from the REST API that were missed
+func (subscription *Subscription) backfill(ctx context.Context, id int32) error {
+	state := subscription.jobs[id]
+	counter := state.contiguous
+
+	for {
+		page, err := subscription.client.job.GetJobEventsAfter(id, counter, 200)
+
+		if err != nil {
+			return err
+		}
+
+		for _, event := range page.Results {
+			event.Job = id
+
+			if err = subscription.deliverEvent(ctx, event); err != nil {
+				return err
+			}
+
+			counter = event.Counter
+		}
+
+		if page.Next == "" || len(page.Results) == 0 {
+			return nil
+		}
+	}
+}
+
+// refresh gets the status of a job from the REST API and backfills its events
+func (subscription *Subscription) refresh(ctx context.Context, id int32) error {
+	job, err := subscription.client.job.GetJob(id)
+
+	if err != nil {
+		return err
+	}
+
+	if err = subscription.setStatus(ctx, id, job.Status); err != nil {
+		return err
+	}
+
+	if err = subscription.backfill(ctx, id); err != nil {
+		return err
+	}
+
+	subscription.jobs[id].eventsComplete = jobs.IsFinishedStatus(job.Status) && job.EventProcessingFinished
+
+	return nil
+}
+
+// catchUp refreshes every job that is not complete, used after each connect
+func (subscription *Subscription) catchUp(ctx context.Context) error {
+	for id, state := range subscription.jobs {
+		if state.finished && state.eventsComplete {
+			continue
+		}
+
+		if err := subscription.refresh(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// finish waits for the events of the finished jobs to be processed and delivers the remaining events
+func (subscription *Subscription) finish(ctx context.Context) error {
+	for !subscription.complete() {
+		if err := subscription.catchUp(ctx); err != nil {
+			return err
+		}
+
+		if subscription.complete() {
+			return nil
+		}
+
+		if !sleep(ctx, subscription.client.ReconnectInterval) {
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// poll delivers the status changes and events of the jobs by polling the REST API
+func (subscription *Subscription) poll(ctx context.Context) error {
+	failures := 0
+
+	for !subscription.complete() {
+		err := subscription.catchUp(ctx)
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if err != nil {
+			failures++
+			subscription.report(err)
+
+			if failures > subscription.client.MaxReconnects {
+				return err
+			}
+		} else {
+			failures = 0
+		}
+
+		if subscription.complete() {
+			return nil
+		}
+
+		if !sleep(ctx, subscription.client.PollInterval) {
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// newSubscribeMessage creates the message that subscribes to the status changes and events of jobs
+//
+//	:param jobIDs: The IDs of the jobs to subscribe to
+//	:param csrfToken: The CSRF token of the session
+func newSubscribeMessage(jobIDs []int32, csrfToken string) ([]byte, error) {
+	if csrfToken == "" {
+		return nil, errors.New("a CSRF token is needed to subscribe")
+	}
+
+	jobEvents := make([]string, 0, len(jobIDs))
+
+	for _, id := range jobIDs {
+		jobEvents = append(jobEvents, strconv.Itoa(int(id)))
+	}
+
+	return json.Marshal(SubscribeMessageSchema{
+		Groups: map[string][]string{
+			"jobs":       {"status_changed"},
+			"job_events": jobEvents,
+		},
+		XrfToken: csrfToken,
+	})
+}
@@ -0,0 +1,282 @@
+/*
+Package websocket provides a client for the job status and job event stream of the Ansible AAP websocket
+*/
+package websocket
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/connection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/jobs"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Client is a client for the AAP websocket
+type Client struct {
+	BaseURL  *url.URL
+	Username string
+	Password string
+	// LoginPath is the path of the session login page, defaults to /api/login/
+	LoginPath string
+	// WebsocketPath is the path of the websocket, defaults to /websocket/
+	WebsocketPath string
+	// ReconnectInterval is the time to wait before reconnecting, defaults to 2 seconds
+	ReconnectInterval time.Duration
+	// MaxReconnects is the number of consecutive failed connections before falling back to polling, defaults to 5
+	MaxReconnects int
+	// PollInterval is the time between polls when falling back to polling, defaults to 5 seconds
+	PollInterval time.Duration
+	tlsConfig    *tls.Config
+	job          *jobs.Job
+}
+
+// NewClient creates a new websocket client that shares the address, credentials and TLS config of a connection
+//
+//	:param basicConnection: The connection to use for logging in and for polling
+func NewClient(basicConnection *connection.Connection) *Client {
+	return &Client{
+		BaseURL:           basicConnection.BaseURL,
+		Username:          basicConnection.Username,
+		Password:          basicConnection.Password,
+		LoginPath:         "/api/login/",
+		WebsocketPath:     "/websocket/",
+		ReconnectInterval: 2 * time.Second,
+		MaxReconnects:     5,
+		PollInterval:      5 * time.Second,
+		tlsConfig:         basicConnection.TLSConfig(),
+		job:               jobs.NewJob(basicConnection),
+	}
+}
+
+// login logs in to get a session and a CSRF token for the websocket handshake
+//
+//	:param ctx: The context used for the requests
+func (client *Client) login(ctx context.Context) (cookieHeader string, csrfToken string, err error) {
+	jar, err := cookiejar.New(nil)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	httpClient := &http.Client{
+		Transport: &http.Transport{TLSClientConfig: client.tlsConfig},
+		Jar:       jar,
+		Timeout:   time.Second * 10,
+		CheckRedirect: func(request *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	loginURL := client.BaseURL.JoinPath(client.LoginPath)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, loginURL.String(), nil)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	response, err := httpClient.Do(request)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	response.Body.Close()
+
+	csrfToken = cookieValue(jar.Cookies(client.BaseURL), "csrftoken")
+
+	form := url.Values{
+		"username": {client.Username},
+		"password": {client.Password},
+		"next":     {"/api/"},
+	}
+
+	request, err = http.NewRequestWithContext(ctx, http.MethodPost, loginURL.String(), strings.NewReader(form.Encode()))
+
+	if err != nil {
+		return "", "", err
+	}
+
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("X-CSRFToken", csrfToken)
+	request.Header.Set("Referer", loginURL.String())
+
+	response, err = httpClient.Do(request)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	response.Body.Close()
+
+	if response.StatusCode >= 400 {
+		return "", "", fmt.Errorf("error websocket login response code %d", response.StatusCode)
+	}
+
+	cookies := jar.Cookies(client.BaseURL)
+
+	if cookieValue(cookies, "sessionid") == "" {
+		return "", "", errors.New("error websocket login, no session was returned")
+	}
+
+	if token := cookieValue(cookies, "csrftoken"); token != "" {
+		csrfToken = token
+	}
+
+	pairs := make([]string, 0, len(cookies))
+
+	for _, cookie := range cookies {
+		pairs = append(pairs, cookie.Name+"="+cookie.Value)
+	}
+
+	return strings.Join(pairs, "; "), csrfToken, nil
+}
+
+// cookieValue gets the value of a cookie by name
+//
+//	:param cookies: The cookies to search
+//	:param name: The name of the cookie
+func cookieValue(cookies []*http.Cookie, name string) string {
+	for _, cookie := range cookies {
+		if cookie.Name == name {
+			return cookie.Value
+		}
+	}
+
+	return ""
+}
+
+// connect logs in, opens the websocket and subscribes to the status changes and events of jobs
+//
+//	:param ctx: The context used for the handshake
+//	:param jobIDs: The IDs of the jobs to subscribe to
+func (client *Client) connect(ctx context.Context, jobIDs []int32) (*wsConn, error) {
+	cookieHeader, csrfToken, err := client.login(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	wsURL := client.BaseURL.JoinPath(client.WebsocketPath)
+
+	if wsURL.Scheme == "https" {
+		wsURL.Scheme = "wss"
+	} else {
+		wsURL.Scheme = "ws"
+	}
+
+	header := http.Header{}
+	header.Set("Cookie", cookieHeader)
+	header.Set("Origin", client.BaseURL.String())
+	header.Set("X-CSRFToken", csrfToken)
+
+	handshakeCtx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	ws, err := dial(handshakeCtx, wsURL, header, client.tlsConfig)
+
+	if err != nil {
+		return nil, err
+	}
+
+	subscribe, err := newSubscribeMessage(jobIDs, csrfToken)
+
+	if err == nil {
+		err = ws.writeText(subscribe)
+	}
+
+	if err != nil {
+		ws.conn.Close()
+		return nil, err
+	}
+
+	return ws, nil
+}
+
+// Subscribe subscribes to the status changes and events of jobs
+//
+// The subscription reconnects when the websocket drops, filling in missed events from the REST API, and falls back
+// to polling the REST API after MaxReconnects consecutive failed connections. The channels of the subscription are
+// closed when every job is finished and its events are delivered, when the context is done or when it is closed,
+// every channel should be read until then so the subscription is never blocked.
+//
+//	:param ctx: The context used to stop the subscription
+//	:param jobIDs: The IDs of the jobs to subscribe to
+func (client *Client) Subscribe(ctx context.Context, jobIDs ...int32) (*Subscription, error) {
+	if len(jobIDs) == 0 {
+		return nil, errors.New("at least one job ID is needed to subscribe")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	statuses := make(chan StatusChangedMessageSchema, 16)
+	events := make(chan jobs.JobEventResponseSingleSchema, 256)
+	errs := make(chan error, 16)
+
+	subscription := &Subscription{
+		Statuses: statuses,
+		Events:   events,
+		Errors:   errs,
+		client:   client,
+		statuses: statuses,
+		events:   events,
+		errs:     errs,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+		jobs:     map[int32]*jobState{},
+	}
+
+	for _, id := range jobIDs {
+		subscription.jobs[id] = &jobState{seen: map[int32]bool{}}
+	}
+
+	go subscription.run(ctx)
+
+	return subscription, nil
+}
+
+// WaitForJob waits for a job to finish, using the websocket and falling back to polling
+//
+//	:param ctx: The context used to stop waiting
+//	:param id: The ID of the job
+func (client *Client) WaitForJob(ctx context.Context, id int32) (status string, err error) {
+	subscription, err := client.Subscribe(ctx, id)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer subscription.Close()
+
+	events := subscription.Events
+	errs := subscription.Errors
+
+	for {
+		select {
+		case message, ok := <-subscription.Statuses:
+			if !ok {
+				if ctx.Err() != nil {
+					return status, ctx.Err()
+				}
+
+				return status, subscription.Err()
+			}
+
+			status = message.Status
+		case _, ok := <-events:
+			if !ok {
+				events = nil
+			}
+		case _, ok := <-errs:
+			if !ok {
+				errs = nil
+			}
+		}
+	}
+}
@@ -0,0 +1,15 @@
+package websocket
+
+// SubscribeMessageSchema is the schema for the message that subscribes to websocket groups
+type SubscribeMessageSchema struct {
+	Groups   map[string][]string `json:"groups" yaml:"groups"`
+	XrfToken string              `json:"xrftoken" yaml:"xrftoken"`
+}
+
+// StatusChangedMessageSchema is the schema for a status change message of the jobs group
+type StatusChangedMessageSchema struct {
+	GroupName    string `json:"group_name" yaml:"group_name"`
+	Type         string `json:"type" yaml:"type"`
+	UnifiedJobID int32  `json:"unified_job_id" yaml:"unified_job_id"`
+	Status       string `json:"status" yaml:"status"`
+}
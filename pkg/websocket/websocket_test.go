@@ -0,0 +1,291 @@
+package websocket
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/connection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/jobs"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// standIn is a local stand-in for the login, REST and websocket endpoints of AAP
+type standIn struct {
+	mutex       sync.Mutex
+	status      string
+	processed   bool
+	events      []jobs.JobEventResponseSingleSchema
+	connections int
+	eventGets   int
+	noWebsocket bool
+	onConnect   func(server *standIn, connection int, ws *wsConn)
+}
+
+func (server *standIn) setJob(status string, processed bool, events ...jobs.JobEventResponseSingleSchema) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.status = status
+	server.processed = processed
+	server.events = events
+}
+
+func (server *standIn) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	switch request.URL.Path {
+	case "/api/login/":
+		if request.Method == http.MethodGet {
+			http.SetCookie(writer, &http.Cookie{Name: "csrftoken", Value: "token", Path: "/"})
+			return
+		}
+
+		cookie, err := request.Cookie("csrftoken")
+
+		if err != nil || cookie.Value != request.Header.Get("X-CSRFToken") || request.FormValue("password") != "password" {
+			writer.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		http.SetCookie(writer, &http.Cookie{Name: "sessionid", Value: "session", Path: "/"})
+		http.Redirect(writer, request, "/api/", http.StatusFound)
+	case "/websocket/":
+		cookie, err := request.Cookie("sessionid")
+
+		if server.noWebsocket || err != nil || cookie.Value != "session" {
+			writer.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		conn, buffer, err := writer.(http.Hijacker).Hijack()
+
+		if err != nil {
+			return
+		}
+
+		_, _ = buffer.WriteString("HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n" +
+			"Sec-WebSocket-Accept: " + acceptKey(request.Header.Get("Sec-WebSocket-Key")) + "\r\n\r\n")
+		_ = buffer.Flush()
+
+		server.connections++
+
+		go server.onConnect(server, server.connections, &wsConn{conn: conn, reader: buffer.Reader})
+	case "/api/v2/jobs/5/":
+		_ = json.NewEncoder(writer).Encode(jobs.JobResponseSingleSchema{ID: 5, Status: server.status, EventProcessingFinished: server.processed})
+	case "/api/v2/jobs/5/job_events/":
+		server.eventGets++
+		counter, _ := strconv.Atoi(request.URL.Query().Get("counter__gt"))
+		results := []jobs.JobEventResponseSingleSchema{}
+
+		for _, event := range server.events {
+			if int(event.Counter) > counter {
+				results = append(results, event)
+			}
+		}
+
+		_ = json.NewEncoder(writer).Encode(jobs.JobEventResponseSchema{Count: int32(len(results)), Results: results})
+	default:
+		writer.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// send writes an unmasked server text frame
+func send(conn net.Conn, data any) {
+	payload, _ := json.Marshal(data)
+	frame := []byte{0x81}
+
+	if len(payload) < 126 {
+		frame = append(frame, byte(len(payload)))
+	} else {
+		frame = append(frame, 126)
+		frame = binary.BigEndian.AppendUint16(frame, uint16(len(payload)))
+	}
+
+	_, _ = conn.Write(append(frame, payload...))
+}
+
+// streamJob plays a job that finishes while the client is connected
+func streamJob(server *standIn, connection int, ws *wsConn) {
+	defer ws.conn.Close()
+
+	message, err := ws.readMessage()
+
+	if err != nil {
+		return
+	}
+
+	subscribe := SubscribeMessageSchema{}
+
+	if json.Unmarshal(message, &subscribe) != nil || subscribe.XrfToken != "token" || subscribe.Groups["job_events"][0] != "5" {
+		return
+	}
+
+	server.waitForCatchUp(connection)
+
+	send(ws.conn, map[string]any{"group_name": "job_events-5", "job": 5, "counter": 2, "event": "runner_on_ok"})
+	send(ws.conn, map[string]any{"group_name": "job_events-5", "job": 5, "counter": 1, "event": "playbook_on_start"})
+	send(ws.conn, map[string]any{"group_name": "jobs", "unified_job_id": 9, "status": "failed"})
+
+	server.setJob("successful", true, event(1), event(2), event(3))
+
+	send(ws.conn, map[string]any{"group_name": "jobs", "unified_job_id": 5, "status": "successful"})
+
+	for {
+		if _, err = ws.readMessage(); err != nil {
+			return
+		}
+	}
+}
+
+// waitForCatchUp waits until the client caught up on a connection from the REST API
+func (server *standIn) waitForCatchUp(connection int) {
+	for i := 0; i < 500; i++ {
+		server.mutex.Lock()
+		eventGets := server.eventGets
+		server.mutex.Unlock()
+
+		if eventGets >= connection {
+			return
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func event(counter int32) jobs.JobEventResponseSingleSchema {
+	return jobs.JobEventResponseSingleSchema{Job: 5, Counter: counter}
+}
+
+// collect reads every channel of a subscription until they are closed
+func collect(t *testing.T, subscription *Subscription) (statuses []string, counters []int32, errs []error) {
+	timeout := time.After(5 * time.Second)
+	statusChannel, eventChannel, errChannel := subscription.Statuses, subscription.Events, subscription.Errors
+
+	for statusChannel != nil || eventChannel != nil || errChannel != nil {
+		select {
+		case status, ok := <-statusChannel:
+			if !ok {
+				statusChannel = nil
+				continue
+			}
+			statuses = append(statuses, status.Status)
+		case event, ok := <-eventChannel:
+			if !ok {
+				eventChannel = nil
+				continue
+			}
+			counters = append(counters, event.Counter)
+		case err, ok := <-errChannel:
+			if !ok {
+				errChannel = nil
+				continue
+			}
+			errs = append(errs, err)
+		case <-timeout:
+			t.Fatal("timed out waiting for the subscription to finish")
+		}
+	}
+
+	return statuses, counters, errs
+}
+
+func newTestClient(t *testing.T, server *standIn) *Client {
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	basicConnection, err := connection.NewConnection(httpServer.URL, "admin", "password", false, "")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := NewClient(basicConnection)
+	client.ReconnectInterval = time.Millisecond
+	client.PollInterval = time.Millisecond
+	client.MaxReconnects = 1
+
+	return client
+}
+
+func TestClient_Subscribe(t *testing.T) {
+	tests := []struct {
+		name       string
+		onConnect  func(server *standIn, connection int, ws *wsConn)
+		wantErrors bool
+	}{
+		{
+			name:      "Test Subscribe stream",
+			onConnect: streamJob,
+		},
+		{
+			name: "Test Subscribe reconnect",
+			onConnect: func(server *standIn, connection int, ws *wsConn) {
+				if connection == 1 {
+					_, _ = ws.readMessage()
+					ws.conn.Close()
+					return
+				}
+
+				streamJob(server, connection, ws)
+			},
+			wantErrors: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := &standIn{onConnect: tt.onConnect}
+			server.setJob("running", false, event(1))
+
+			subscription, err := newTestClient(t, server).Subscribe(context.Background(), 5)
+
+			if err != nil {
+				t.Fatalf("Client.Subscribe() error = %v", err)
+			}
+
+			statuses, counters, errs := collect(t, subscription)
+
+			if len(statuses) != 2 || statuses[0] != "running" || statuses[1] != "successful" {
+				t.Errorf("Client.Subscribe() statuses = %v, want [running successful]", statuses)
+			}
+
+			if len(counters) != 3 || counters[0] != 1 || counters[1] != 2 || counters[2] != 3 {
+				t.Errorf("Client.Subscribe() events = %v, want [1 2 3]", counters)
+			}
+
+			if (len(errs) > 0) != tt.wantErrors {
+				t.Errorf("Client.Subscribe() errors = %v, wantErrors %v", errs, tt.wantErrors)
+			}
+
+			if subscription.Polling() || subscription.Err() != nil {
+				t.Errorf("Client.Subscribe() polling = %v, err = %v", subscription.Polling(), subscription.Err())
+			}
+		})
+	}
+}
+
+func TestClient_Subscribe_Fallback(t *testing.T) {
+	server := &standIn{noWebsocket: true}
+	server.setJob("successful", true, event(1), event(2))
+
+	client := newTestClient(t, server)
+
+	status, err := client.WaitForJob(context.Background(), 5)
+
+	if err != nil || status != "successful" {
+		t.Fatalf("Client.WaitForJob() = %s, %v, want successful", status, err)
+	}
+
+	subscription, _ := client.Subscribe(context.Background(), 5)
+	_, counters, _ := collect(t, subscription)
+
+	if !subscription.Polling() || len(counters) != 2 {
+		t.Errorf("Client.Subscribe() polling = %v, events = %v, want polling with 2 events", subscription.Polling(), counters)
+	}
+}
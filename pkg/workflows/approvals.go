@@ -0,0 +1,261 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/common"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/jobs"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/polling"
+	"strconv"
+	"time"
+)
+
+// Workflow approval statuses, a denied approval and an approval that timed out are both failed
+const (
+	ApprovalPending  = "pending"
+	ApprovalApproved = "successful"
+	ApprovalDenied   = "failed"
+	ApprovalCanceled = "canceled"
+)
+
+// ApprovalFilter filters the workflow approvals to list
+type ApprovalFilter struct {
+	// Status filters by status, empty lists approvals of any status
+	Status string
+	// WorkflowJob filters by the ID of the workflow job the approval belongs to
+	WorkflowJob int32
+	// WorkflowJobTemplate filters by the ID of the workflow job template the approval belongs to
+	WorkflowJobTemplate int32
+	// Name filters by the name of the approval
+	Name string
+}
+
+// params converts the filter to query params
+func (filter ApprovalFilter) params() map[string]string {
+	params := map[string]string{
+		"order_by":  "id",
+		"page_size": "200",
+	}
+
+	if filter.Status != "" {
+		params["status"] = filter.Status
+	}
+
+	if filter.WorkflowJob != 0 {
+		params["unified_job_node__workflow_job"] = strconv.Itoa(int(filter.WorkflowJob))
+	}
+
+	if filter.WorkflowJobTemplate != 0 {
+		params["unified_job_node__workflow_job__workflow_job_template"] = strconv.Itoa(int(filter.WorkflowJobTemplate))
+	}
+
+	if filter.Name != "" {
+		params["name"] = filter.Name
+	}
+
+	return params
+}
+
+// Approved checks if the approval was approved
+func (approval WorkflowApprovalResponseSingleSchema) Approved() bool {
+	return approval.Status == ApprovalApproved
+}
+
+// Denied checks if the approval was denied, an approval that timed out is not denied
+func (approval WorkflowApprovalResponseSingleSchema) Denied() bool {
+	return approval.Status == ApprovalDenied && !approval.TimedOut
+}
+
+// Resolved checks if the approval is no longer pending
+func (approval WorkflowApprovalResponseSingleSchema) Resolved() bool {
+	return jobs.IsFinishedStatus(approval.Status)
+}
+
+// GetWorkflowApprovals gets the workflow approvals that match a filter
+//
+//	:param filter: The filter to use
+func (workflow *WorkflowJobTemplate) GetWorkflowApprovals(filter ApprovalFilter) (approvals []WorkflowApprovalResponseSingleSchema, err error) {
+	return common.GetAllPages[WorkflowApprovalResponseSingleSchema](workflow.connection, workflow.DataConversion, workflow.WorkflowApprovalURI, filter.params())
+}
+
+// GetPendingWorkflowApprovals gets the pending workflow approvals that match a filter
+//
+//	:param filter: The filter to use, the status is always pending
+func (workflow *WorkflowJobTemplate) GetPendingWorkflowApprovals(filter ApprovalFilter) (approvals []WorkflowApprovalResponseSingleSchema, err error) {
+	filter.Status = ApprovalPending
+
+	return workflow.GetWorkflowApprovals(filter)
+}
+
+// GetWorkflowApproval gets a workflow approval by ID
+//
+//	:param id: The ID of the workflow approval
+func (workflow *WorkflowJobTemplate) GetWorkflowApproval(id int32) (schemaResponse WorkflowApprovalResponseSingleSchema, err error) {
+	schemaResponse = WorkflowApprovalResponseSingleSchema{}
+
+	uri := fmt.Sprintf("%s%d/", workflow.WorkflowApprovalURI, id)
+
+	response, err := workflow.connection.Get(uri, nil)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = workflow.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// ApproveWorkflowApproval approves a pending workflow approval
+//
+//	:param id: The ID of the workflow approval
+func (workflow *WorkflowJobTemplate) ApproveWorkflowApproval(id int32) (statusCode int, err error) {
+	return workflow.resolveWorkflowApproval(id, "approve")
+}
+
+// DenyWorkflowApproval denies a pending workflow approval
+//
+//	:param id: The ID of the workflow approval
+func (workflow *WorkflowJobTemplate) DenyWorkflowApproval(id int32) (statusCode int, err error) {
+	return workflow.resolveWorkflowApproval(id, "deny")
+}
+
+// resolveWorkflowApproval posts to the approve or deny endpoint of a workflow approval
+//
+//	:param id: The ID of the workflow approval
+//	:param action: The action (approve, deny)
+func (workflow *WorkflowJobTemplate) resolveWorkflowApproval(id int32, action string) (statusCode int, err error) {
+	uri := fmt.Sprintf("%s%d/%s/", workflow.WorkflowApprovalURI, id, action)
+
+	response, err := workflow.connection.Post(uri, []byte("{}"))
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer response.Body.Close()
+
+	return response.StatusCode, nil
+}
+
+// WaitForWorkflowApproval polls a workflow approval until it is approved, denied, canceled or times out
+//
+//	:param ctx: The context used to stop waiting
+//	:param id: The ID of the workflow approval
+//	:param options: The poll options, the interval must be positive
+func (workflow *WorkflowJobTemplate) WaitForWorkflowApproval(ctx context.Context, id int32, options polling.Options) (approval WorkflowApprovalResponseSingleSchema, err error) {
+	err = polling.Poll(ctx, options, func() (bool, error) {
+		approval, err = workflow.GetWorkflowApproval(id)
+
+		return approval.Resolved(), err
+	})
+
+	if errors.Is(err, polling.ErrTimeout) {
+		return approval, fmt.Errorf("workflow approval %d still %s: %w", id, approval.Status, err)
+	}
+
+	return approval, err
+}
+
+// GetPendingApprovals gets the pending approvals of the launched workflow job
+func (workflowManagement *WorkflowManagement) GetPendingApprovals() (approvals []WorkflowApprovalResponseSingleSchema, err error) {
+	workflowJobID := workflowManagement.WorkflowJobID()
+
+	if workflowJobID == 0 {
+		return []WorkflowApprovalResponseSingleSchema{}, errors.New("no workflow job has been launched")
+	}
+
+	return workflowManagement.workflow.GetPendingWorkflowApprovals(ApprovalFilter{WorkflowJob: workflowJobID})
+}
+
+// WaitForApproval waits until the launched workflow job reaches an approval node and the approval is resolved
+//
+// When the workflow job finishes without a pending approval the returned approval is empty and found is false. The
+// poll options set with SetPollOptions are used, their maximum wait covers both waits.
+//
+//	:param ctx: The context used to stop waiting
+func (workflowManagement *WorkflowManagement) WaitForApproval(ctx context.Context) (approval WorkflowApprovalResponseSingleSchema, found bool, err error) {
+	workflowJobID := workflowManagement.WorkflowJobID()
+
+	if workflowJobID == 0 {
+		return approval, false, errors.New("no workflow job has been launched")
+	}
+
+	options := workflowManagement.pollOptions.WithDefaults()
+	started := time.Now()
+	status := "new"
+
+	err = polling.Poll(ctx, options, func() (bool, error) {
+		approvals, err := workflowManagement.GetPendingApprovals()
+
+		if err != nil {
+			return false, err
+		}
+
+		if len(approvals) > 0 {
+			approval, found = approvals[0], true
+
+			return true, nil
+		}
+
+		status, err = workflowManagement.workflow.GetWorkflowJobStatus(workflowJobID)
+
+		return jobs.IsFinishedStatus(status), err
+	})
+
+	if errors.Is(err, polling.ErrTimeout) {
+		return approval, false, fmt.Errorf("no approval for workflow job %d, still %s: %w", workflowJobID, status, err)
+	}
+
+	if err != nil || !found {
+		return approval, false, err
+	}
+
+	if options.MaxWait > 0 {
+		options.MaxWait = max(options.MaxWait-time.Since(started), time.Nanosecond)
+	}
+
+	approval, err = workflowManagement.workflow.WaitForWorkflowApproval(ctx, approval.ID, options)
+
+	return approval, true, err
+}
+
+// Approve approves every pending approval of the launched workflow job
+func (workflowManagement *WorkflowManagement) Approve() (err error) {
+	return workflowManagement.resolvePendingApprovals(workflowManagement.workflow.ApproveWorkflowApproval)
+}
+
+// Deny denies every pending approval of the launched workflow job
+func (workflowManagement *WorkflowManagement) Deny() (err error) {
+	return workflowManagement.resolvePendingApprovals(workflowManagement.workflow.DenyWorkflowApproval)
+}
+
+// resolvePendingApprovals approves or denies every pending approval of the launched workflow job
+//
+//	:param resolve: The function that approves or denies an approval
+func (workflowManagement *WorkflowManagement) resolvePendingApprovals(resolve func(id int32) (int, error)) (err error) {
+	approvals, err := workflowManagement.GetPendingApprovals()
+
+	if err != nil {
+		return err
+	}
+
+	if len(approvals) == 0 {
+		return fmt.Errorf("workflow job %d has no pending approvals", workflowManagement.WorkflowJobID())
+	}
+
+	var errs []error
+
+	for _, approval := range approvals {
+		if _, err = resolve(approval.ID); err != nil {
+			errs = append(errs, fmt.Errorf("approval %d: %w", approval.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
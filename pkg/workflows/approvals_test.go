@@ -0,0 +1,96 @@
+package workflows
+
+import (
+	"context"
+	"github.com/btr1975/go-ansible-aap-api-client/internal/fakeconnection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/polling"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+)
+
+// newFakeApprovalConnection serves a workflow job that reaches an approval which is approved after a few polls
+func newFakeApprovalConnection() (connection *fakeconnection.Connection, approvalPolls *int) {
+	approvalPolls = new(int)
+
+	connection = fakeconnection.New().
+		HandleJSON(http.MethodGet, "workflow_approvals/", WorkflowApprovalResponseSchema{Count: 1, Results: []WorkflowApprovalResponseSingleSchema{{ID: 7, Status: ApprovalPending}}}).
+		HandleJSON(http.MethodGet, "workflow_jobs/50/", WorkflowJobResponseSingleSchema{ID: 50, Status: "running"}).
+		HandleJSON(http.MethodPost, "workflow_approvals/7/deny/", map[string]any{})
+
+	connection.Handle(http.MethodGet, "workflow_approvals/7/", func(fakeconnection.Request) (*http.Response, error) {
+		*approvalPolls++
+		approval := WorkflowApprovalResponseSingleSchema{ID: 7, Status: ApprovalPending}
+
+		if *approvalPolls >= 3 {
+			approval.Status = ApprovalApproved
+		}
+
+		return fakeconnection.JSON(approval)
+	})
+
+	return connection, approvalPolls
+}
+
+func TestApprovalFilter_params(t *testing.T) {
+	params := ApprovalFilter{Status: ApprovalPending, WorkflowJob: 50, WorkflowJobTemplate: 3}.params()
+
+	want := map[string]string{
+		"status":                         "pending",
+		"unified_job_node__workflow_job": "50",
+		"unified_job_node__workflow_job__workflow_job_template": "3",
+	}
+
+	for key, value := range want {
+		if params[key] != value {
+			t.Errorf("ApprovalFilter.params()[%s] = %q, want %q", key, params[key], value)
+		}
+	}
+
+	if _, ok := (ApprovalFilter{}).params()["status"]; ok {
+		t.Errorf("ApprovalFilter.params() empty filter has a status")
+	}
+}
+
+func TestWorkflowManagement_WaitForApproval(t *testing.T) {
+	connection, approvalPolls := newFakeApprovalConnection()
+	workflowManagement := &WorkflowManagement{workflow: NewWorkflowJobTemplate(connection)}
+	workflowManagement.SetPollOptions(polling.Options{Interval: time.Millisecond, MaxWait: time.Second})
+
+	if _, _, err := workflowManagement.WaitForApproval(context.Background()); err == nil {
+		t.Errorf("WorkflowManagement.WaitForApproval() error = nil, want no workflow job error")
+	}
+
+	workflowManagement.setWorkflowJobID(50)
+
+	approval, found, err := workflowManagement.WaitForApproval(context.Background())
+
+	if err != nil || !found {
+		t.Fatalf("WorkflowManagement.WaitForApproval() found = %v, err = %v", found, err)
+	}
+
+	if !approval.Approved() || approval.Denied() || *approvalPolls != 3 {
+		t.Errorf("WorkflowManagement.WaitForApproval() approval = %+v after %d polls", approval, *approvalPolls)
+	}
+
+	params := map[string]string{}
+
+	for _, request := range connection.Requests() {
+		if request.URI == "workflow_approvals/" {
+			params = request.Params
+		}
+	}
+
+	if params["status"] != ApprovalPending || params["unified_job_node__workflow_job"] != "50" {
+		t.Errorf("WorkflowManagement.WaitForApproval() params = %v", params)
+	}
+
+	err = workflowManagement.Deny()
+
+	posts := connection.Calls(http.MethodPost)
+
+	if err != nil || !slices.Equal(posts, []string{"POST workflow_approvals/7/deny/"}) {
+		t.Errorf("WorkflowManagement.Deny() posts = %v, err = %v", posts, err)
+	}
+}
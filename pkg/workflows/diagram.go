@@ -0,0 +1,305 @@
+package workflows
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// DiagramNode is a node of a workflow diagram
+type DiagramNode struct {
+	// ID is the ID of the node in the diagram
+	ID string
+	// Label is the text shown in the node
+	Label string
+	// Status is the status of the job the node ran, empty for a workflow job template
+	Status string
+}
+
+// Diagram is a workflow job template or workflow job as nodes and edges that can be rendered as DOT or Mermaid
+type Diagram struct {
+	Title string
+	Nodes []DiagramNode
+	Edges []WorkflowEdge
+}
+
+// diagramColor is the stroke and fill color of a node or edge
+type diagramColor struct {
+	stroke string
+	fill   string
+}
+
+// statusColors are the colors of the node job statuses
+var statusColors = map[string]diagramColor{
+	"successful":  {stroke: "#2e7d32", fill: "#c8e6c9"},
+	"failed":      {stroke: "#c62828", fill: "#ffcdd2"},
+	"error":       {stroke: "#c62828", fill: "#ffcdd2"},
+	"canceled":    {stroke: "#616161", fill: "#e0e0e0"},
+	"running":     {stroke: "#1565c0", fill: "#bbdefb"},
+	"pending":     {stroke: "#f9a825", fill: "#fff9c4"},
+	"waiting":     {stroke: "#f9a825", fill: "#fff9c4"},
+	"do not run":  {stroke: "#9e9e9e", fill: "#fafafa"},
+	"not started": {stroke: "#9e9e9e", fill: "#fafafa"},
+}
+
+// edgeColors are the colors of the edge types, the same as the workflow visualizer
+var edgeColors = map[string]string{
+	EdgeSuccess: "#5cb85c",
+	EdgeFailure: "#d9534f",
+	EdgeAlways:  "#337ab7",
+}
+
+// NewDiagramFromGraph creates a diagram of a workflow graph
+//
+//	:param title: The title of the diagram
+//	:param graph: The graph
+func NewDiagramFromGraph(title string, graph *WorkflowGraph) *Diagram {
+	diagram := &Diagram{Title: title, Nodes: []DiagramNode{}, Edges: []WorkflowEdge{}}
+	nodeIDs := map[string]string{}
+
+	for i, node := range graph.Nodes {
+		label := node.Identifier
+
+		if node.Type == NodeTypeApproval && node.Approval != nil {
+			label = fmt.Sprintf("%s\n%s", node.Identifier, node.Approval.Name)
+		}
+
+		nodeIDs[node.Identifier] = diagramNodeID(int32(i + 1))
+		diagram.Nodes = append(diagram.Nodes, DiagramNode{ID: nodeIDs[node.Identifier], Label: label})
+	}
+
+	for _, node := range graph.Nodes {
+		for _, edgeType := range []string{EdgeSuccess, EdgeFailure, EdgeAlways} {
+			for _, child := range graph.Children(node.Identifier, edgeType) {
+				diagram.Edges = append(diagram.Edges, WorkflowEdge{From: nodeIDs[node.Identifier], To: nodeIDs[child], Type: edgeType})
+			}
+		}
+	}
+
+	return diagram
+}
+
+// NewDiagramFromTemplateNodes creates a diagram of the nodes of a workflow job template
+//
+//	:param title: The title of the diagram
+//	:param nodes: The nodes of the workflow job template
+func NewDiagramFromTemplateNodes(title string, nodes []WorkflowNodeResponseSingleSchema) *Diagram {
+	diagram := &Diagram{Title: title, Nodes: []DiagramNode{}, Edges: []WorkflowEdge{}}
+
+	for _, node := range nodes {
+		diagram.Nodes = append(diagram.Nodes, DiagramNode{
+			ID:    diagramNodeID(node.ID),
+			Label: diagramLabel(node.Identifier, node.SummaryFields.UnifiedJobTemplate.Name),
+		})
+
+		diagram.addEdges(node.ID, node.SuccessNodes, node.FailureNodes, node.AlwaysNodes)
+	}
+
+	return diagram
+}
+
+// NewDiagramFromJobNodes creates a diagram of the nodes of a workflow job with the status of the job each node ran
+//
+//	:param title: The title of the diagram
+//	:param nodes: The nodes of the workflow job
+func NewDiagramFromJobNodes(title string, nodes []WorkflowJobNodeResponseSingleSchema) *Diagram {
+	diagram := &Diagram{Title: title, Nodes: []DiagramNode{}, Edges: []WorkflowEdge{}}
+
+	for _, node := range nodes {
+		status := node.JobStatus()
+
+		diagram.Nodes = append(diagram.Nodes, DiagramNode{
+			ID:     diagramNodeID(node.ID),
+			Label:  fmt.Sprintf("%s\n%s", diagramLabel(node.Identifier, node.SummaryFields.UnifiedJobTemplate.Name), status),
+			Status: status,
+		})
+
+		diagram.addEdges(node.ID, node.SuccessNodes, node.FailureNodes, node.AlwaysNodes)
+	}
+
+	return diagram
+}
+
+// GetWorkflowJobTemplateDiagram gets a diagram of a workflow job template
+//
+//	:param id: The ID of the workflow job template
+func (workflow *WorkflowJobTemplate) GetWorkflowJobTemplateDiagram(id int32) (diagram *Diagram, err error) {
+	workflowJobTemplate, err := workflow.GetWorkflowJobTemplateByID(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := workflow.GetWorkflowNodes(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDiagramFromTemplateNodes(workflowJobTemplate.Name, nodes), nil
+}
+
+// GetWorkflowJobDiagram gets a diagram of a workflow job with the status of the job each node ran
+//
+//	:param id: The ID of the workflow job
+func (workflow *WorkflowJobTemplate) GetWorkflowJobDiagram(id int32) (diagram *Diagram, err error) {
+	workflowJob, err := workflow.GetWorkflowJob(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := workflow.GetWorkflowJobNodes(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDiagramFromJobNodes(fmt.Sprintf("%s #%d", workflowJob.Name, id), nodes), nil
+}
+
+// addEdges adds the edges from a node to its children
+//
+//	:param parentID: The ID of the parent node
+//	:param successNodes: The IDs of the children run on success
+//	:param failureNodes: The IDs of the children run on failure
+//	:param alwaysNodes: The IDs of the children always run
+func (diagram *Diagram) addEdges(parentID int32, successNodes []int32, failureNodes []int32, alwaysNodes []int32) {
+	children := map[string][]int32{
+		EdgeSuccess: successNodes,
+		EdgeFailure: failureNodes,
+		EdgeAlways:  alwaysNodes,
+	}
+
+	for _, edgeType := range []string{EdgeSuccess, EdgeFailure, EdgeAlways} {
+		childIDs := slices.Clone(children[edgeType])
+		slices.Sort(childIDs)
+
+		for _, childID := range childIDs {
+			diagram.Edges = append(diagram.Edges, WorkflowEdge{From: diagramNodeID(parentID), To: diagramNodeID(childID), Type: edgeType})
+		}
+	}
+}
+
+// diagramNodeID gets the diagram ID of a node
+//
+//	:param id: The ID of the node
+func diagramNodeID(id int32) string {
+	return fmt.Sprintf("node%d", id)
+}
+
+// diagramLabel gets the label of a node from its identifier and the name of its unified job template
+//
+//	:param identifier: The identifier of the node
+//	:param name: The name of the unified job template
+func diagramLabel(identifier string, name string) string {
+	switch {
+	case name == "":
+		return identifier
+	case identifier == "" || identifier == name:
+		return name
+	default:
+		return fmt.Sprintf("%s\n%s", name, identifier)
+	}
+}
+
+// DOT renders the diagram as Graphviz DOT
+//
+//	:param colorByStatus: Whether to color the nodes by the status of the job they ran
+func (diagram *Diagram) DOT(colorByStatus bool) string {
+	builder := strings.Builder{}
+
+	fmt.Fprintf(&builder, "digraph %s {\n", dotQuote(diagram.Title))
+	builder.WriteString("  rankdir=LR;\n")
+	builder.WriteString("  node [shape=box, style=\"rounded,filled\", fillcolor=\"#ffffff\"];\n")
+
+	for _, node := range diagram.Nodes {
+		attributes := []string{"label=" + dotQuote(node.Label)}
+
+		if color, ok := statusColors[node.Status]; colorByStatus && ok {
+			attributes = append(attributes, fmt.Sprintf("color=%q", color.stroke), fmt.Sprintf("fillcolor=%q", color.fill))
+		}
+
+		fmt.Fprintf(&builder, "  %s [%s];\n", dotQuote(node.ID), strings.Join(attributes, ", "))
+	}
+
+	for _, edge := range diagram.Edges {
+		fmt.Fprintf(&builder, "  %s -> %s [label=%q, color=%q];\n", dotQuote(edge.From), dotQuote(edge.To), edge.Type, edgeColors[edge.Type])
+	}
+
+	builder.WriteString("}\n")
+
+	return builder.String()
+}
+
+// Mermaid renders the diagram as a Mermaid flowchart
+//
+//	:param colorByStatus: Whether to color the nodes by the status of the job they ran
+func (diagram *Diagram) Mermaid(colorByStatus bool) string {
+	builder := strings.Builder{}
+
+	if diagram.Title != "" {
+		fmt.Fprintf(&builder, "---\ntitle: %q\n---\n", diagram.Title)
+	}
+
+	builder.WriteString("flowchart LR\n")
+
+	for _, node := range diagram.Nodes {
+		fmt.Fprintf(&builder, "  %s[\"%s\"]\n", node.ID, mermaidText(node.Label))
+	}
+
+	for _, edge := range diagram.Edges {
+		fmt.Fprintf(&builder, "  %s -->|%s| %s\n", edge.From, edge.Type, edge.To)
+	}
+
+	for i, edge := range diagram.Edges {
+		fmt.Fprintf(&builder, "  linkStyle %d stroke:%s\n", i, edgeColors[edge.Type])
+	}
+
+	if !colorByStatus {
+		return builder.String()
+	}
+
+	classes := map[string][]string{}
+	statuses := []string{}
+
+	for _, node := range diagram.Nodes {
+		if _, ok := statusColors[node.Status]; !ok {
+			continue
+		}
+
+		if _, ok := classes[node.Status]; !ok {
+			statuses = append(statuses, node.Status)
+		}
+
+		classes[node.Status] = append(classes[node.Status], node.ID)
+	}
+
+	for _, status := range statuses {
+		className := strings.ReplaceAll(status, " ", "_")
+		color := statusColors[status]
+
+		fmt.Fprintf(&builder, "  classDef %s fill:%s,stroke:%s\n", className, color.fill, color.stroke)
+		fmt.Fprintf(&builder, "  class %s %s\n", strings.Join(classes[status], ","), className)
+	}
+
+	return builder.String()
+}
+
+// dotQuote quotes text as a DOT string, keeping line breaks
+//
+//	:param text: The text to quote
+func dotQuote(text string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+	return `"` + replacer.Replace(text) + `"`
+}
+
+// mermaidText escapes text for a Mermaid label, keeping line breaks
+//
+//	:param text: The text to escape
+func mermaidText(text string) string {
+	replacer := strings.NewReplacer(`"`, "#quot;", "\n", "<br/>")
+
+	return replacer.Replace(text)
+}
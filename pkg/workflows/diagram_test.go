@@ -0,0 +1,92 @@
+package workflows
+
+import (
+	"strings"
+	"testing"
+)
+
+func newTestJobNodes() []WorkflowJobNodeResponseSingleSchema {
+	sync := WorkflowJobNodeResponseSingleSchema{ID: 1, Identifier: "sync", SuccessNodes: []int32{2}}
+	sync.SummaryFields.UnifiedJobTemplate.Name = "Network Project"
+	sync.SummaryFields.Job.Status = "successful"
+
+	deploy := WorkflowJobNodeResponseSingleSchema{ID: 2, Identifier: "deploy", FailureNodes: []int32{3}, AlwaysNodes: []int32{4}}
+	deploy.SummaryFields.UnifiedJobTemplate.Name = `Deploy "core"`
+	deploy.SummaryFields.Job.Status = "failed"
+
+	rollback := WorkflowJobNodeResponseSingleSchema{ID: 3, Identifier: "rollback"}
+	rollback.SummaryFields.UnifiedJobTemplate.Name = "rollback"
+	rollback.SummaryFields.Job.Status = "successful"
+
+	notify := WorkflowJobNodeResponseSingleSchema{ID: 4, Identifier: "notify", DoNotRun: true}
+
+	return []WorkflowJobNodeResponseSingleSchema{sync, deploy, rollback, notify}
+}
+
+func TestDiagram_DOT(t *testing.T) {
+	dot := NewDiagramFromJobNodes("Change #10", newTestJobNodes()).DOT(true)
+
+	want := []string{
+		`digraph "Change #10" {`,
+		`"node1" [label="Network Project\nsync\nsuccessful", color="#2e7d32", fillcolor="#c8e6c9"];`,
+		`"node2" [label="Deploy \"core\"\ndeploy\nfailed", color="#c62828", fillcolor="#ffcdd2"];`,
+		`"node3" [label="rollback\nsuccessful", color="#2e7d32", fillcolor="#c8e6c9"];`,
+		`"node4" [label="notify\ndo not run", color="#9e9e9e", fillcolor="#fafafa"];`,
+		`"node1" -> "node2" [label="success", color="#5cb85c"];`,
+		`"node2" -> "node3" [label="failure", color="#d9534f"];`,
+		`"node2" -> "node4" [label="always", color="#337ab7"];`,
+	}
+
+	for _, line := range want {
+		if !strings.Contains(dot, line) {
+			t.Errorf("Diagram.DOT() missing %s in\n%s", line, dot)
+		}
+	}
+
+	if plain := NewDiagramFromJobNodes("Change #10", newTestJobNodes()).DOT(false); strings.Contains(plain, "#c8e6c9") {
+		t.Errorf("Diagram.DOT() colored by status when not asked to\n%s", plain)
+	}
+}
+
+func TestDiagram_Mermaid(t *testing.T) {
+	mermaid := NewDiagramFromJobNodes("Change #10", newTestJobNodes()).Mermaid(true)
+
+	want := []string{
+		`title: "Change #10"`,
+		"flowchart LR",
+		`node2["Deploy #quot;core#quot;<br/>deploy<br/>failed"]`,
+		"node1 -->|success| node2",
+		"node2 -->|failure| node3",
+		"node2 -->|always| node4",
+		"linkStyle 1 stroke:#d9534f",
+		"classDef successful fill:#c8e6c9,stroke:#2e7d32",
+		"class node1,node3 successful",
+		"class node4 do_not_run",
+	}
+
+	for _, line := range want {
+		if !strings.Contains(mermaid, line) {
+			t.Errorf("Diagram.Mermaid() missing %s in\n%s", line, mermaid)
+		}
+	}
+}
+
+func TestNewDiagramFromGraph(t *testing.T) {
+	diagram := NewDiagramFromGraph("Change", newTestGraph())
+
+	if len(diagram.Nodes) != 5 || len(diagram.Edges) != 4 {
+		t.Fatalf("NewDiagramFromGraph() = %+v", diagram)
+	}
+
+	mermaid := diagram.Mermaid(false)
+
+	for _, line := range []string{`node3["approve<br/>Approve change"]`, "node4 -->|failure| node5"} {
+		if !strings.Contains(mermaid, line) {
+			t.Errorf("Diagram.Mermaid() missing %s in\n%s", line, mermaid)
+		}
+	}
+
+	if strings.Contains(mermaid, "classDef") {
+		t.Errorf("Diagram.Mermaid() colored by status when not asked to\n%s", mermaid)
+	}
+}
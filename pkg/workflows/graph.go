@@ -0,0 +1,321 @@
+package workflows
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Workflow node types
+const (
+	NodeTypeJobTemplate   = "job_template"
+	NodeTypeProjectSync   = "project_sync"
+	NodeTypeInventorySync = "inventory_sync"
+	NodeTypeApproval      = "approval"
+	NodeTypeWorkflow      = "workflow"
+)
+
+// Workflow edge types, the condition on the parent node for the child node to run
+const (
+	EdgeSuccess = "success"
+	EdgeFailure = "failure"
+	EdgeAlways  = "always"
+)
+
+// WorkflowNode is a node of a workflow graph
+type WorkflowNode struct {
+	// Identifier is unique within the workflow and is used to match the node on the server
+	Identifier string
+	// Type is one of the node types
+	Type string
+	// UnifiedJobTemplate is the ID of the job template, project, inventory source or workflow job template
+	// the node runs, it is not used for approval nodes
+	UnifiedJobTemplate int32
+	// Approval is the approval template of an approval node
+	Approval *ApprovalTemplateRequestSchema
+	// Prompts are the prompts given to the job the node runs
+	Prompts WorkflowNodePromptsSchema
+	// AllParentsMustConverge makes the node wait for every parent instead of any parent
+	AllParentsMustConverge bool
+}
+
+// WorkflowEdge is an edge of a workflow graph from a parent node to a child node
+type WorkflowEdge struct {
+	From string
+	To   string
+	Type string
+}
+
+// String gets the edge as text
+func (edge WorkflowEdge) String() string {
+	return fmt.Sprintf("%s -%s-> %s", edge.From, edge.Type, edge.To)
+}
+
+// WorkflowGraph is the graph of nodes and edges of a workflow job template
+type WorkflowGraph struct {
+	Nodes []WorkflowNode
+	Edges []WorkflowEdge
+}
+
+// NewWorkflowGraph creates a new empty workflow graph
+func NewWorkflowGraph() *WorkflowGraph {
+	return &WorkflowGraph{
+		Nodes: []WorkflowNode{},
+		Edges: []WorkflowEdge{},
+	}
+}
+
+// AddNode adds a node to the graph
+//
+//	:param node: The node to add
+func (graph *WorkflowGraph) AddNode(node WorkflowNode) *WorkflowGraph {
+	graph.Nodes = append(graph.Nodes, node)
+
+	return graph
+}
+
+// AddJobTemplateNode adds a node that runs a job template
+//
+//	:param identifier: The identifier of the node
+//	:param jobTemplateID: The ID of the job template
+//	:param prompts: The prompts given to the job
+func (graph *WorkflowGraph) AddJobTemplateNode(identifier string, jobTemplateID int32, prompts WorkflowNodePromptsSchema) *WorkflowGraph {
+	return graph.AddNode(WorkflowNode{Identifier: identifier, Type: NodeTypeJobTemplate, UnifiedJobTemplate: jobTemplateID, Prompts: prompts})
+}
+
+// AddProjectSyncNode adds a node that syncs a project
+//
+//	:param identifier: The identifier of the node
+//	:param projectID: The ID of the project
+func (graph *WorkflowGraph) AddProjectSyncNode(identifier string, projectID int32) *WorkflowGraph {
+	return graph.AddNode(WorkflowNode{Identifier: identifier, Type: NodeTypeProjectSync, UnifiedJobTemplate: projectID})
+}
+
+// AddInventorySyncNode adds a node that syncs an inventory source
+//
+//	:param identifier: The identifier of the node
+//	:param inventorySourceID: The ID of the inventory source
+func (graph *WorkflowGraph) AddInventorySyncNode(identifier string, inventorySourceID int32) *WorkflowGraph {
+	return graph.AddNode(WorkflowNode{Identifier: identifier, Type: NodeTypeInventorySync, UnifiedJobTemplate: inventorySourceID})
+}
+
+// AddApprovalNode adds a node that waits for an approval
+//
+//	:param identifier: The identifier of the node
+//	:param approval: The approval template
+func (graph *WorkflowGraph) AddApprovalNode(identifier string, approval ApprovalTemplateRequestSchema) *WorkflowGraph {
+	return graph.AddNode(WorkflowNode{Identifier: identifier, Type: NodeTypeApproval, Approval: &approval})
+}
+
+// AddWorkflowNode adds a node that runs a nested workflow job template
+//
+//	:param identifier: The identifier of the node
+//	:param workflowID: The ID of the nested workflow job template
+//	:param prompts: The prompts given to the nested workflow
+func (graph *WorkflowGraph) AddWorkflowNode(identifier string, workflowID int32, prompts WorkflowNodePromptsSchema) *WorkflowGraph {
+	return graph.AddNode(WorkflowNode{Identifier: identifier, Type: NodeTypeWorkflow, UnifiedJobTemplate: workflowID, Prompts: prompts})
+}
+
+// Connect adds an edge from a parent node to a child node
+//
+//	:param from: The identifier of the parent node
+//	:param to: The identifier of the child node
+//	:param edgeType: The edge type (success, failure, always)
+func (graph *WorkflowGraph) Connect(from string, to string, edgeType string) *WorkflowGraph {
+	graph.Edges = append(graph.Edges, WorkflowEdge{From: from, To: to, Type: edgeType})
+
+	return graph
+}
+
+// OnSuccess adds an edge that runs the child node when the parent node succeeds
+//
+//	:param from: The identifier of the parent node
+//	:param to: The identifier of the child node
+func (graph *WorkflowGraph) OnSuccess(from string, to string) *WorkflowGraph {
+	return graph.Connect(from, to, EdgeSuccess)
+}
+
+// OnFailure adds an edge that runs the child node when the parent node fails
+//
+//	:param from: The identifier of the parent node
+//	:param to: The identifier of the child node
+func (graph *WorkflowGraph) OnFailure(from string, to string) *WorkflowGraph {
+	return graph.Connect(from, to, EdgeFailure)
+}
+
+// Always adds an edge that runs the child node whatever the outcome of the parent node
+//
+//	:param from: The identifier of the parent node
+//	:param to: The identifier of the child node
+func (graph *WorkflowGraph) Always(from string, to string) *WorkflowGraph {
+	return graph.Connect(from, to, EdgeAlways)
+}
+
+// Node gets a node by identifier
+//
+//	:param identifier: The identifier of the node
+func (graph *WorkflowGraph) Node(identifier string) (node WorkflowNode, ok bool) {
+	for _, node := range graph.Nodes {
+		if node.Identifier == identifier {
+			return node, true
+		}
+	}
+
+	return WorkflowNode{}, false
+}
+
+// Children gets the identifiers of the child nodes of a node for an edge type, sorted
+//
+//	:param identifier: The identifier of the parent node
+//	:param edgeType: The edge type
+func (graph *WorkflowGraph) Children(identifier string, edgeType string) (children []string) {
+	children = []string{}
+
+	for _, edge := range graph.Edges {
+		if edge.From == identifier && edge.Type == edgeType {
+			children = append(children, edge.To)
+		}
+	}
+
+	slices.Sort(children)
+
+	return children
+}
+
+// Roots gets the identifiers of the nodes without a parent, in the order they were added
+func (graph *WorkflowGraph) Roots() (roots []string) {
+	roots = []string{}
+	hasParent := map[string]bool{}
+
+	for _, edge := range graph.Edges {
+		hasParent[edge.To] = true
+	}
+
+	for _, node := range graph.Nodes {
+		if !hasParent[node.Identifier] {
+			roots = append(roots, node.Identifier)
+		}
+	}
+
+	return roots
+}
+
+// Validate validates the graph for invalid nodes, missing references, duplicate edges, pairs of nodes with more than
+// one edge and cycles
+func (graph *WorkflowGraph) Validate() (err error) {
+	var errs []error
+
+	nodes := map[string]bool{}
+
+	for i, node := range graph.Nodes {
+		if node.Identifier == "" {
+			errs = append(errs, fmt.Errorf("node %d has no identifier", i))
+			continue
+		}
+
+		if nodes[node.Identifier] {
+			errs = append(errs, fmt.Errorf("node %s is defined more than once", node.Identifier))
+		}
+
+		nodes[node.Identifier] = true
+
+		switch node.Type {
+		case NodeTypeApproval:
+			if node.Approval == nil || node.Approval.Name == "" {
+				errs = append(errs, fmt.Errorf("approval node %s has no approval name", node.Identifier))
+			}
+		case NodeTypeJobTemplate, NodeTypeProjectSync, NodeTypeInventorySync, NodeTypeWorkflow:
+			if node.UnifiedJobTemplate == 0 {
+				errs = append(errs, fmt.Errorf("%s node %s has no unified job template", node.Type, node.Identifier))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("node %s has an invalid type %q", node.Identifier, node.Type))
+		}
+	}
+
+	edges := map[WorkflowEdge]bool{}
+	pairs := map[[2]string]string{}
+
+	for _, edge := range graph.Edges {
+		switch edge.Type {
+		case EdgeSuccess, EdgeFailure, EdgeAlways:
+		default:
+			errs = append(errs, fmt.Errorf("edge %s has an invalid type", edge))
+		}
+
+		if !nodes[edge.From] {
+			errs = append(errs, fmt.Errorf("edge %s references a missing node %s", edge, edge.From))
+		}
+
+		if !nodes[edge.To] {
+			errs = append(errs, fmt.Errorf("edge %s references a missing node %s", edge, edge.To))
+		}
+
+		if edges[edge] {
+			errs = append(errs, fmt.Errorf("edge %s is defined more than once", edge))
+		} else if edgeType, ok := pairs[[2]string{edge.From, edge.To}]; ok {
+			errs = append(errs, fmt.Errorf("edge %s connects nodes that already have a %s edge", edge, edgeType))
+		}
+
+		edges[edge] = true
+		pairs[[2]string{edge.From, edge.To}] = edge.Type
+	}
+
+	if cycle := graph.findCycle(); cycle != nil {
+		errs = append(errs, fmt.Errorf("workflow has a cycle: %s", strings.Join(cycle, " -> ")))
+	}
+
+	return errors.Join(errs...)
+}
+
+// findCycle finds a cycle in the graph, nil when there is none
+func (graph *WorkflowGraph) findCycle() (cycle []string) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := map[string]int{}
+	path := []string{}
+
+	var visit func(identifier string) []string
+
+	visit = func(identifier string) []string {
+		state[identifier] = visiting
+		path = append(path, identifier)
+
+		for _, edge := range graph.Edges {
+			if edge.From != identifier {
+				continue
+			}
+
+			switch state[edge.To] {
+			case visiting:
+				start := slices.Index(path, edge.To)
+
+				return append(slices.Clone(path[start:]), edge.To)
+			case unvisited:
+				if found := visit(edge.To); found != nil {
+					return found
+				}
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[identifier] = visited
+
+		return nil
+	}
+
+	for _, node := range graph.Nodes {
+		if state[node.Identifier] == unvisited {
+			if found := visit(node.Identifier); found != nil {
+				return found
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package workflows
+
+// WorkflowNodePromptsSchema is the schema for the prompts of a workflow node
+type WorkflowNodePromptsSchema struct {
+	ExtraData            map[string]any `json:"extra_data,omitempty" yaml:"extra_data,omitempty"`
+	Inventory            int32          `json:"inventory,omitempty" yaml:"inventory,omitempty"`
+	Limit                string         `json:"limit,omitempty" yaml:"limit,omitempty"`
+	JobTags              string         `json:"job_tags,omitempty" yaml:"job_tags,omitempty"`
+	SkipTags             string         `json:"skip_tags,omitempty" yaml:"skip_tags,omitempty"`
+	JobType              string         `json:"job_type,omitempty" yaml:"job_type,omitempty"`
+	ScmBranch            string         `json:"scm_branch,omitempty" yaml:"scm_branch,omitempty"`
+	Verbosity            *int32         `json:"verbosity,omitempty" yaml:"verbosity,omitempty"`
+	DiffMode             *bool          `json:"diff_mode,omitempty" yaml:"diff_mode,omitempty"`
+	Forks                *int32         `json:"forks,omitempty" yaml:"forks,omitempty"`
+	JobSliceCount        *int32         `json:"job_slice_count,omitempty" yaml:"job_slice_count,omitempty"`
+	Timeout              *int32         `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	ExecutionEnvironment int32          `json:"execution_environment,omitempty" yaml:"execution_environment,omitempty"`
+}
+
+// WorkflowNodeRequestSchema is the schema for a workflow job template node request
+type WorkflowNodeRequestSchema struct {
+	Identifier             string `json:"identifier" yaml:"identifier"`
+	UnifiedJobTemplate     int32  `json:"unified_job_template,omitempty" yaml:"unified_job_template,omitempty"`
+	AllParentsMustConverge bool   `json:"all_parents_must_converge" yaml:"all_parents_must_converge"`
+	WorkflowNodePromptsSchema
+}
+
+// WorkflowNodeReplaceRequestSchema is the schema for a request that sets every field of a workflow job template
+// node, the fields that are nil are sent as null so the server clears them
+type WorkflowNodeReplaceRequestSchema struct {
+	Identifier             string         `json:"identifier" yaml:"identifier"`
+	UnifiedJobTemplate     *int32         `json:"unified_job_template" yaml:"unified_job_template"`
+	AllParentsMustConverge bool           `json:"all_parents_must_converge" yaml:"all_parents_must_converge"`
+	ExtraData              map[string]any `json:"extra_data" yaml:"extra_data"`
+	Inventory              *int32         `json:"inventory" yaml:"inventory"`
+	Limit                  *string        `json:"limit" yaml:"limit"`
+	JobTags                *string        `json:"job_tags" yaml:"job_tags"`
+	SkipTags               *string        `json:"skip_tags" yaml:"skip_tags"`
+	JobType                *string        `json:"job_type" yaml:"job_type"`
+	ScmBranch              *string        `json:"scm_branch" yaml:"scm_branch"`
+	Verbosity              *int32         `json:"verbosity" yaml:"verbosity"`
+	DiffMode               *bool          `json:"diff_mode" yaml:"diff_mode"`
+	Forks                  *int32         `json:"forks" yaml:"forks"`
+	JobSliceCount          *int32         `json:"job_slice_count" yaml:"job_slice_count"`
+	Timeout                *int32         `json:"timeout" yaml:"timeout"`
+	ExecutionEnvironment   *int32         `json:"execution_environment" yaml:"execution_environment"`
+}
+
+// ApprovalTemplateRequestSchema is the schema for the approval template of an approval node
+type ApprovalTemplateRequestSchema struct {
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description" yaml:"description"`
+	// Timeout is the number of seconds before the approval times out, 0 never times out
+	Timeout int32 `json:"timeout" yaml:"timeout"`
+}
+
+// WorkflowNodeSummaryFieldsSchema is the schema for the summary fields of a workflow job template node
+type WorkflowNodeSummaryFieldsSchema struct {
+	UnifiedJobTemplate WorkflowNodeTemplateSummarySchema `json:"unified_job_template" yaml:"unified_job_template"`
+}
+
+// WorkflowNodeResponseSingleSchema is the schema for a single workflow job template node response item
+type WorkflowNodeResponseSingleSchema struct {
+	ID                     int32                           `json:"id" yaml:"id"`
+	Type                   string                          `json:"type" yaml:"type"`
+	URL                    string                          `json:"url" yaml:"url"`
+	Created                string                          `json:"created" yaml:"created"`
+	Modified               string                          `json:"modified" yaml:"modified"`
+	SummaryFields          WorkflowNodeSummaryFieldsSchema `json:"summary_fields" yaml:"summary_fields"`
+	WorkflowJobTemplate    int32                           `json:"workflow_job_template" yaml:"workflow_job_template"`
+	UnifiedJobTemplate     int32                           `json:"unified_job_template" yaml:"unified_job_template"`
+	SuccessNodes           []int32                         `json:"success_nodes" yaml:"success_nodes"`
+	FailureNodes           []int32                         `json:"failure_nodes" yaml:"failure_nodes"`
+	AlwaysNodes            []int32                         `json:"always_nodes" yaml:"always_nodes"`
+	AllParentsMustConverge bool                            `json:"all_parents_must_converge" yaml:"all_parents_must_converge"`
+	Identifier             string                          `json:"identifier" yaml:"identifier"`
+	WorkflowNodePromptsSchema
+}
+
+// WorkflowNodeResponseSchema is the schema for a workflow job template node response
+type WorkflowNodeResponseSchema struct {
+	Count    int32                              `json:"count" yaml:"count"`
+	Next     string                             `json:"next" yaml:"next"`
+	Previous string                             `json:"previous" yaml:"previous"`
+	Results  []WorkflowNodeResponseSingleSchema `json:"results" yaml:"results"`
+}
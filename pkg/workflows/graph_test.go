@@ -0,0 +1,385 @@
+package workflows
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/btr1975/go-ansible-aap-api-client/internal/fakeconnection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/common"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func newTestGraph() *WorkflowGraph {
+	return NewWorkflowGraph().
+		AddProjectSyncNode("sync", 10).
+		AddJobTemplateNode("backup", 20, WorkflowNodePromptsSchema{Limit: "switches"}).
+		AddApprovalNode("approve", ApprovalTemplateRequestSchema{Name: "Approve change", Timeout: 3600}).
+		AddJobTemplateNode("deploy", 21, WorkflowNodePromptsSchema{ExtraData: map[string]any{"change": 42}}).
+		AddJobTemplateNode("rollback", 22, WorkflowNodePromptsSchema{}).
+		OnSuccess("sync", "backup").
+		OnSuccess("backup", "approve").
+		OnSuccess("approve", "deploy").
+		OnFailure("deploy", "rollback")
+}
+
+func TestWorkflowGraph_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		graph   *WorkflowGraph
+		wantErr []string
+	}{
+		{
+			name:  "Test Validate valid",
+			graph: newTestGraph(),
+		},
+		{
+			name:    "Test Validate cycle",
+			graph:   newTestGraph().Always("rollback", "backup"),
+			wantErr: []string{"workflow has a cycle: backup -> approve -> deploy -> rollback -> backup"},
+		},
+		{
+			name:    "Test Validate two edge types between a pair",
+			graph:   newTestGraph().Always("deploy", "rollback"),
+			wantErr: []string{"edge deploy -always-> rollback connects nodes that already have a failure edge"},
+		},
+		{
+			name:    "Test Validate missing reference",
+			graph:   newTestGraph().OnSuccess("deploy", "notify"),
+			wantErr: []string{"edge deploy -success-> notify references a missing node notify"},
+		},
+		{
+			name: "Test Validate invalid nodes",
+			graph: newTestGraph().
+				AddJobTemplateNode("backup", 20, WorkflowNodePromptsSchema{}).
+				AddNode(WorkflowNode{Identifier: "gate", Type: NodeTypeApproval}).
+				AddNode(WorkflowNode{Identifier: "nested", Type: NodeTypeWorkflow}).
+				Connect("sync", "deploy", "sometimes"),
+			wantErr: []string{
+				"node backup is defined more than once",
+				"approval node gate has no approval name",
+				"workflow node nested has no unified job template",
+				`edge sync -sometimes-> deploy has an invalid type`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.graph.Validate()
+
+			if len(tt.wantErr) == 0 {
+				if err != nil {
+					t.Errorf("WorkflowGraph.Validate() error = %v", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("WorkflowGraph.Validate() error = nil, want %v", tt.wantErr)
+			}
+
+			for _, want := range tt.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("WorkflowGraph.Validate() error = %v, want %s", err, want)
+				}
+			}
+		})
+	}
+}
+
+// fakeNodeServer keeps the nodes of workflow job template 1 in memory
+type fakeNodeServer struct {
+	*fakeconnection.Connection
+	nextID int32
+	nodes  map[int32]*WorkflowNodeResponseSingleSchema
+}
+
+func newFakeNodeServer() *fakeNodeServer {
+	server := &fakeNodeServer{Connection: fakeconnection.New(), nodes: map[int32]*WorkflowNodeResponseSingleSchema{}}
+
+	server.Handle(http.MethodGet, "workflow_job_templates/1/workflow_nodes/", server.list).
+		Handle(http.MethodPost, "workflow_job_templates/1/workflow_nodes/", server.create).
+		HandlePrefix(http.MethodPost, "workflow_job_template_nodes/", server.post).
+		HandlePrefix(http.MethodPatch, "workflow_job_template_nodes/", server.patch).
+		HandlePrefix(http.MethodDelete, "workflow_job_template_nodes/", server.delete)
+
+	return server
+}
+
+// writes counts the requests that changed the nodes
+func (server *fakeNodeServer) writes() int {
+	return len(server.Calls(http.MethodPost, http.MethodPatch, http.MethodDelete))
+}
+
+func (server *fakeNodeServer) list(fakeconnection.Request) (*http.Response, error) {
+	results := []WorkflowNodeResponseSingleSchema{}
+
+	for id := int32(1); id <= server.nextID; id++ {
+		if node, ok := server.nodes[id]; ok {
+			results = append(results, *node)
+		}
+	}
+
+	return fakeconnection.JSON(WorkflowNodeResponseSchema{Count: int32(len(results)), Results: results})
+}
+
+func (server *fakeNodeServer) create(request fakeconnection.Request) (*http.Response, error) {
+	server.nextID++
+	node := &WorkflowNodeResponseSingleSchema{ID: server.nextID}
+	server.apply(node, request.Data)
+	server.nodes[node.ID] = node
+
+	return fakeconnection.JSON(node)
+}
+
+func (server *fakeNodeServer) post(request fakeconnection.Request) (*http.Response, error) {
+	id := request.ID()
+	endpoint := strings.Split(request.URI, "/")[2]
+	node := server.nodes[id]
+
+	if endpoint == "create_approval_template" {
+		approval := ApprovalTemplateRequestSchema{}
+		_ = request.Decode(&approval)
+		node.UnifiedJobTemplate = 900 + id
+		node.SummaryFields.UnifiedJobTemplate = WorkflowNodeTemplateSummarySchema{Name: approval.Name, UnifiedJobType: "workflow_approval", Timeout: approval.Timeout}
+
+		return fakeconnection.JSON(node)
+	}
+
+	association := common.AssociationRequestSchema{}
+	_ = request.Decode(&association)
+
+	children := map[string]*[]int32{"success_nodes": &node.SuccessNodes, "failure_nodes": &node.FailureNodes, "always_nodes": &node.AlwaysNodes}[endpoint]
+
+	if association.Disassociate {
+		*children = slices.DeleteFunc(*children, func(child int32) bool { return child == association.ID })
+
+		return fakeconnection.NoContent()
+	}
+
+	if slices.Contains(server.children(id), association.ID) {
+		return nil, errors.New("relationship not allowed")
+	}
+
+	if server.reaches(association.ID, id) {
+		return nil, errors.New("cycle detected")
+	}
+
+	*children = append(*children, association.ID)
+
+	return fakeconnection.NoContent()
+}
+
+// children gets the child nodes of a node for every edge type
+func (server *fakeNodeServer) children(id int32) []int32 {
+	node := server.nodes[id]
+
+	return slices.Concat(node.SuccessNodes, node.FailureNodes, node.AlwaysNodes)
+}
+
+// reaches checks if a node can be reached from another node, as AWX does before adding an edge
+func (server *fakeNodeServer) reaches(from int32, to int32) bool {
+	if from == to {
+		return true
+	}
+
+	for _, child := range server.children(from) {
+		if server.reaches(child, to) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// apply applies a create or patch request to a node, fields that are not sent are kept and null fields are cleared
+func (server *fakeNodeServer) apply(node *WorkflowNodeResponseSingleSchema, data []byte) {
+	fields := map[string]any{}
+	current, _ := json.Marshal(node)
+	_ = json.Unmarshal(current, &fields)
+
+	request := map[string]any{}
+	_ = json.Unmarshal(data, &request)
+
+	for key, value := range request {
+		fields[key] = value
+
+		if value == nil {
+			delete(fields, key)
+		}
+	}
+
+	if value, ok := request["unified_job_template"]; ok && value == nil {
+		delete(fields, "summary_fields")
+	}
+
+	merged, _ := json.Marshal(fields)
+	*node = WorkflowNodeResponseSingleSchema{}
+	_ = json.Unmarshal(merged, node)
+}
+
+func (server *fakeNodeServer) patch(request fakeconnection.Request) (*http.Response, error) {
+	node := server.nodes[request.ID()]
+	server.apply(node, request.Data)
+
+	return fakeconnection.JSON(node)
+}
+
+func (server *fakeNodeServer) delete(request fakeconnection.Request) (*http.Response, error) {
+	id := request.ID()
+
+	if _, ok := server.nodes[id]; !ok {
+		return fakeconnection.Status(http.StatusNotFound, map[string]string{"detail": "Not found."})
+	}
+
+	delete(server.nodes, id)
+
+	for _, node := range server.nodes {
+		for _, children := range []*[]int32{&node.SuccessNodes, &node.FailureNodes, &node.AlwaysNodes} {
+			*children = slices.DeleteFunc(*children, func(child int32) bool { return child == id })
+		}
+	}
+
+	return fakeconnection.NoContent()
+}
+
+func TestWorkflowJobTemplate_ReconcileWorkflowGraph(t *testing.T) {
+	connection := newFakeNodeServer()
+	workflow := NewWorkflowJobTemplate(connection)
+
+	report, err := workflow.ReconcileWorkflowGraph(1, newTestGraph())
+
+	if err != nil {
+		t.Fatalf("WorkflowJobTemplate.ReconcileWorkflowGraph() error = %v", err)
+	}
+
+	if len(report.CreatedNodes) != 5 || len(report.AddedEdges) != 4 {
+		t.Errorf("WorkflowJobTemplate.ReconcileWorkflowGraph() create report = %+v", report)
+	}
+
+	writes := connection.writes()
+
+	report, err = workflow.ReconcileWorkflowGraph(1, newTestGraph())
+
+	if err != nil || report.Changed() || connection.writes() != writes {
+		t.Errorf("WorkflowJobTemplate.ReconcileWorkflowGraph() second run report = %+v, err = %v, want no changes", report, err)
+	}
+
+	timeoutOnly := newTestGraph()
+	timeoutOnly.Nodes[2].Approval = &ApprovalTemplateRequestSchema{Name: "Approve change", Timeout: 600}
+
+	report, err = workflow.ReconcileWorkflowGraph(1, timeoutOnly)
+
+	if err != nil || !slices.Equal(report.UpdatedNodes, []string{"approve"}) || connection.nodes[3].SummaryFields.UnifiedJobTemplate.Timeout != 600 {
+		t.Errorf("WorkflowJobTemplate.ReconcileWorkflowGraph() timeout report = %+v, err = %v", report, err)
+	}
+
+	changed := NewWorkflowGraph().
+		AddProjectSyncNode("sync", 10).
+		AddJobTemplateNode("backup", 20, WorkflowNodePromptsSchema{Limit: "routers"}).
+		AddJobTemplateNode("deploy", 21, WorkflowNodePromptsSchema{ExtraData: map[string]any{"change": 42}}).
+		OnSuccess("sync", "backup").
+		Always("backup", "deploy")
+
+	report, err = workflow.ReconcileWorkflowGraph(1, changed)
+
+	if err != nil {
+		t.Fatalf("WorkflowJobTemplate.ReconcileWorkflowGraph() error = %v", err)
+	}
+
+	if !slices.Equal(report.UpdatedNodes, []string{"backup"}) || !slices.Equal(report.DeletedNodes, []string{"approve", "rollback"}) {
+		t.Errorf("WorkflowJobTemplate.ReconcileWorkflowGraph() node report = %+v", report)
+	}
+
+	if !slices.Equal(report.AddedEdges, []string{"backup -always-> deploy"}) || len(report.RemovedEdges) != 0 {
+		t.Errorf("WorkflowJobTemplate.ReconcileWorkflowGraph() edge report = %+v", report)
+	}
+}
+
+func TestWorkflowJobTemplate_ReconcileWorkflowGraph_edges(t *testing.T) {
+	tests := []struct {
+		name        string
+		changed     *WorkflowGraph
+		wantRemoved []string
+		wantAdded   []string
+	}{
+		{
+			name:        "Test ReconcileWorkflowGraph edge type change",
+			changed:     NewWorkflowGraph().AddProjectSyncNode("a", 10).AddProjectSyncNode("b", 11).OnSuccess("a", "b"),
+			wantRemoved: []string{"a -failure-> b"},
+			wantAdded:   []string{"a -success-> b"},
+		},
+		{
+			name:        "Test ReconcileWorkflowGraph edge reversal",
+			changed:     NewWorkflowGraph().AddProjectSyncNode("b", 11).AddProjectSyncNode("a", 10).OnFailure("b", "a"),
+			wantRemoved: []string{"a -failure-> b"},
+			wantAdded:   []string{"b -failure-> a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			connection := newFakeNodeServer()
+			workflow := NewWorkflowJobTemplate(connection)
+
+			original := NewWorkflowGraph().AddProjectSyncNode("a", 10).AddProjectSyncNode("b", 11).OnFailure("a", "b")
+
+			if _, err := workflow.ReconcileWorkflowGraph(1, original); err != nil {
+				t.Fatalf("WorkflowJobTemplate.ReconcileWorkflowGraph() error = %v", err)
+			}
+
+			report, err := workflow.ReconcileWorkflowGraph(1, tt.changed)
+
+			if err != nil {
+				t.Fatalf("WorkflowJobTemplate.ReconcileWorkflowGraph() error = %v", err)
+			}
+
+			if !slices.Equal(report.RemovedEdges, tt.wantRemoved) || !slices.Equal(report.AddedEdges, tt.wantAdded) {
+				t.Errorf("WorkflowJobTemplate.ReconcileWorkflowGraph() edge report = %+v", report)
+			}
+		})
+	}
+}
+
+func TestWorkflowJobTemplate_ReconcileWorkflowGraph_converges(t *testing.T) {
+	connection := newFakeNodeServer()
+	workflow := NewWorkflowJobTemplate(connection)
+
+	verbosity := int32(2)
+
+	if _, err := workflow.ReconcileWorkflowGraph(1, NewWorkflowGraph().
+		AddJobTemplateNode("backup", 20, WorkflowNodePromptsSchema{Limit: "switches", Inventory: 3, Verbosity: &verbosity, ExtraData: map[string]any{"change": 42}}).
+		AddJobTemplateNode("deploy", 21, WorkflowNodePromptsSchema{})); err != nil {
+		t.Fatalf("WorkflowJobTemplate.ReconcileWorkflowGraph() error = %v", err)
+	}
+
+	changed := func() *WorkflowGraph {
+		return NewWorkflowGraph().
+			AddJobTemplateNode("backup", 20, WorkflowNodePromptsSchema{}).
+			AddApprovalNode("deploy", ApprovalTemplateRequestSchema{Name: "Approve deploy"})
+	}
+
+	report, err := workflow.ReconcileWorkflowGraph(1, changed())
+
+	if err != nil || !slices.Equal(report.UpdatedNodes, []string{"backup", "deploy"}) {
+		t.Fatalf("WorkflowJobTemplate.ReconcileWorkflowGraph() report = %+v, err = %v", report, err)
+	}
+
+	backup := connection.nodes[1]
+
+	if backup.Limit != "" || backup.Inventory != 0 || backup.Verbosity != nil || len(backup.ExtraData) != 0 {
+		t.Errorf("WorkflowJobTemplate.ReconcileWorkflowGraph() backup prompts = %+v, want cleared", backup.WorkflowNodePromptsSchema)
+	}
+
+	if deploy := connection.nodes[2]; deploy.UnifiedJobTemplate != 902 || !deploy.isApproval() {
+		t.Errorf("WorkflowJobTemplate.ReconcileWorkflowGraph() deploy = %+v, want an approval node", deploy)
+	}
+
+	writes := connection.writes()
+
+	report, err = workflow.ReconcileWorkflowGraph(1, changed())
+
+	if err != nil || report.Changed() || connection.writes() != writes {
+		t.Errorf("WorkflowJobTemplate.ReconcileWorkflowGraph() second run report = %+v, err = %v, want no changes", report, err)
+	}
+}
@@ -0,0 +1,442 @@
+package workflows
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/common"
+	"slices"
+)
+
+// ReconcileReport is the report of the changes made to reconcile a workflow graph
+type ReconcileReport struct {
+	CreatedNodes []string
+	UpdatedNodes []string
+	DeletedNodes []string
+	AddedEdges   []string
+	RemovedEdges []string
+}
+
+// Changed gets whether anything was changed
+func (report ReconcileReport) Changed() bool {
+	return len(report.CreatedNodes)+len(report.UpdatedNodes)+len(report.DeletedNodes)+len(report.AddedEdges)+len(report.RemovedEdges) > 0
+}
+
+// edgeEndpoints are the node endpoints of each edge type
+var edgeEndpoints = map[string]string{
+	EdgeSuccess: "success_nodes",
+	EdgeFailure: "failure_nodes",
+	EdgeAlways:  "always_nodes",
+}
+
+// GetWorkflowNodes gets every node of a workflow job template
+//
+//	:param id: The ID of the workflow job template
+func (workflow *WorkflowJobTemplate) GetWorkflowNodes(id int32) (nodes []WorkflowNodeResponseSingleSchema, err error) {
+	uri := fmt.Sprintf("%s%d/workflow_nodes/", workflow.URI, id)
+
+	return common.GetAllPages[WorkflowNodeResponseSingleSchema](workflow.connection, workflow.DataConversion, uri, nil)
+}
+
+// CreateWorkflowNode creates a node in a workflow job template
+//
+//	:param id: The ID of the workflow job template
+//	:param nodeRequest: The node request schema to use
+func (workflow *WorkflowJobTemplate) CreateWorkflowNode(id int32, nodeRequest WorkflowNodeRequestSchema) (schemaResponse WorkflowNodeResponseSingleSchema, err error) {
+	schemaResponse = WorkflowNodeResponseSingleSchema{}
+
+	uri := fmt.Sprintf("%s%d/workflow_nodes/", workflow.URI, id)
+
+	data, err := json.Marshal(nodeRequest)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	response, err := workflow.connection.Post(uri, data)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = workflow.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// UpdateWorkflowNode updates a workflow job template node by ID, the fields that are not set are left unchanged
+//
+//	:param nodeID: The ID of the node to update
+//	:param nodeRequest: The node request schema to use
+func (workflow *WorkflowJobTemplate) UpdateWorkflowNode(nodeID int32, nodeRequest WorkflowNodeRequestSchema) (schemaResponse WorkflowNodeResponseSingleSchema, err error) {
+	return workflow.patchWorkflowNode(nodeID, nodeRequest)
+}
+
+// ReplaceWorkflowNode sets every field of a workflow job template node by ID, the fields that are nil are cleared
+//
+//	:param nodeID: The ID of the node to replace
+//	:param nodeRequest: The node replace request schema to use
+func (workflow *WorkflowJobTemplate) ReplaceWorkflowNode(nodeID int32, nodeRequest WorkflowNodeReplaceRequestSchema) (schemaResponse WorkflowNodeResponseSingleSchema, err error) {
+	return workflow.patchWorkflowNode(nodeID, nodeRequest)
+}
+
+// patchWorkflowNode patches a workflow job template node by ID
+//
+//	:param nodeID: The ID of the node to patch
+//	:param nodeRequest: The request to send
+func (workflow *WorkflowJobTemplate) patchWorkflowNode(nodeID int32, nodeRequest any) (schemaResponse WorkflowNodeResponseSingleSchema, err error) {
+	schemaResponse = WorkflowNodeResponseSingleSchema{}
+
+	uri := fmt.Sprintf("%s%d/", workflow.WorkflowNodeURI, nodeID)
+
+	data, err := json.Marshal(nodeRequest)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	response, err := workflow.connection.Patch(uri, data)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = workflow.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// DeleteWorkflowNode deletes a workflow job template node by ID
+//
+//	:param nodeID: The ID of the node to delete
+func (workflow *WorkflowJobTemplate) DeleteWorkflowNode(nodeID int32) (statusCode int, err error) {
+	uri := fmt.Sprintf("%s%d/", workflow.WorkflowNodeURI, nodeID)
+
+	response, err := workflow.connection.Delete(uri, nil)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response.StatusCode, nil
+}
+
+// CreateApprovalTemplate creates or updates the approval template of an approval node
+//
+//	:param nodeID: The ID of the node
+//	:param approvalRequest: The approval template request schema to use
+func (workflow *WorkflowJobTemplate) CreateApprovalTemplate(nodeID int32, approvalRequest ApprovalTemplateRequestSchema) (statusCode int, err error) {
+	uri := fmt.Sprintf("%s%d/create_approval_template/", workflow.WorkflowNodeURI, nodeID)
+
+	data, err := json.Marshal(approvalRequest)
+
+	if err != nil {
+		return 0, err
+	}
+
+	response, err := workflow.connection.Post(uri, data)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer response.Body.Close()
+
+	return response.StatusCode, nil
+}
+
+// AssociateWorkflowNode adds an edge from a parent node to a child node
+//
+//	:param parentID: The ID of the parent node
+//	:param childID: The ID of the child node
+//	:param edgeType: The edge type (success, failure, always)
+func (workflow *WorkflowJobTemplate) AssociateWorkflowNode(parentID int32, childID int32, edgeType string) (statusCode int, err error) {
+	return workflow.associateNode(parentID, childID, edgeType, false)
+}
+
+// DisassociateWorkflowNode removes an edge from a parent node to a child node
+//
+//	:param parentID: The ID of the parent node
+//	:param childID: The ID of the child node
+//	:param edgeType: The edge type (success, failure, always)
+func (workflow *WorkflowJobTemplate) DisassociateWorkflowNode(parentID int32, childID int32, edgeType string) (statusCode int, err error) {
+	return workflow.associateNode(parentID, childID, edgeType, true)
+}
+
+// associateNode posts an association request to the child node endpoint of an edge type
+//
+//	:param parentID: The ID of the parent node
+//	:param childID: The ID of the child node
+//	:param edgeType: The edge type (success, failure, always)
+//	:param disassociate: Whether to disassociate instead of associate
+func (workflow *WorkflowJobTemplate) associateNode(parentID int32, childID int32, edgeType string, disassociate bool) (statusCode int, err error) {
+	endpoint, ok := edgeEndpoints[edgeType]
+
+	if !ok {
+		return 0, fmt.Errorf("invalid edge type %q", edgeType)
+	}
+
+	uri := fmt.Sprintf("%s%d/%s/", workflow.WorkflowNodeURI, parentID, endpoint)
+
+	return common.Associate(workflow.connection, uri, childID, disassociate)
+}
+
+// nodeRequest converts a graph node to a node request
+func (node WorkflowNode) nodeRequest() WorkflowNodeRequestSchema {
+	nodeRequest := WorkflowNodeRequestSchema{
+		Identifier:                node.Identifier,
+		AllParentsMustConverge:    node.AllParentsMustConverge,
+		WorkflowNodePromptsSchema: node.Prompts,
+	}
+
+	if node.Type != NodeTypeApproval {
+		nodeRequest.UnifiedJobTemplate = node.UnifiedJobTemplate
+	}
+
+	return nodeRequest
+}
+
+// replaceRequest converts a graph node to a request that replaces an existing node, clearing every prompt the graph
+// node does not set
+//
+// An approval node keeps the approval template of an existing approval node, the job template of a node that is
+// turned into an approval node is cleared.
+//
+//	:param existing: The existing node
+func (node WorkflowNode) replaceRequest(existing WorkflowNodeResponseSingleSchema) WorkflowNodeReplaceRequestSchema {
+	prompts := node.Prompts
+
+	nodeRequest := WorkflowNodeReplaceRequestSchema{
+		Identifier:             node.Identifier,
+		AllParentsMustConverge: node.AllParentsMustConverge,
+		ExtraData:              prompts.ExtraData,
+		Inventory:              nonZero(prompts.Inventory),
+		Limit:                  nonZero(prompts.Limit),
+		JobTags:                nonZero(prompts.JobTags),
+		SkipTags:               nonZero(prompts.SkipTags),
+		JobType:                nonZero(prompts.JobType),
+		ScmBranch:              nonZero(prompts.ScmBranch),
+		Verbosity:              prompts.Verbosity,
+		DiffMode:               prompts.DiffMode,
+		Forks:                  prompts.Forks,
+		JobSliceCount:          prompts.JobSliceCount,
+		Timeout:                prompts.Timeout,
+		ExecutionEnvironment:   nonZero(prompts.ExecutionEnvironment),
+	}
+
+	if nodeRequest.ExtraData == nil {
+		nodeRequest.ExtraData = map[string]any{}
+	}
+
+	switch {
+	case node.Type != NodeTypeApproval:
+		nodeRequest.UnifiedJobTemplate = nonZero(node.UnifiedJobTemplate)
+	case existing.isApproval():
+		nodeRequest.UnifiedJobTemplate = nonZero(existing.UnifiedJobTemplate)
+	}
+
+	return nodeRequest
+}
+
+// nonZero gets a pointer to a value, nil for the zero value so it is sent as null
+//
+//	:param value: The value
+func nonZero[T comparable](value T) *T {
+	var zero T
+
+	if value == zero {
+		return nil
+	}
+
+	return &value
+}
+
+// isApproval checks if an existing node runs an approval template
+func (existing WorkflowNodeResponseSingleSchema) isApproval() bool {
+	return existing.SummaryFields.UnifiedJobTemplate.UnifiedJobType == "workflow_approval"
+}
+
+// needsUpdate checks if an existing node differs from the graph node
+//
+//	:param existing: The existing node
+func (node WorkflowNode) needsUpdate(existing WorkflowNodeResponseSingleSchema) bool {
+	if node.Type == NodeTypeApproval && existing.UnifiedJobTemplate != 0 && !existing.isApproval() {
+		return true
+	}
+
+	if node.Type != NodeTypeApproval && existing.UnifiedJobTemplate != node.UnifiedJobTemplate {
+		return true
+	}
+
+	if existing.AllParentsMustConverge != node.AllParentsMustConverge {
+		return true
+	}
+
+	existingPrompts, _ := json.Marshal(existing.WorkflowNodePromptsSchema)
+	prompts, _ := json.Marshal(node.Prompts)
+
+	return !bytes.Equal(existingPrompts, prompts)
+}
+
+// needsApproval checks if the approval template of an existing node differs from the graph node
+//
+//	:param existing: The existing node
+func (node WorkflowNode) needsApproval(existing WorkflowNodeResponseSingleSchema) bool {
+	summary := existing.SummaryFields.UnifiedJobTemplate
+
+	return !existing.isApproval() || summary.Name != node.Approval.Name || summary.Description != node.Approval.Description || summary.Timeout != node.Approval.Timeout
+}
+
+// ReconcileWorkflowGraph creates or updates the nodes and edges of a workflow job template to match a graph
+//
+// Nodes are matched by identifier, existing nodes that are not in the graph are deleted and edges that are not
+// in the graph are removed.
+//
+//	:param id: The ID of the workflow job template
+//	:param graph: The graph to reconcile
+func (workflow *WorkflowJobTemplate) ReconcileWorkflowGraph(id int32, graph *WorkflowGraph) (report ReconcileReport, err error) {
+	err = graph.Validate()
+
+	if err != nil {
+		return report, err
+	}
+
+	existingNodes, err := workflow.GetWorkflowNodes(id)
+
+	if err != nil {
+		return report, err
+	}
+
+	existingByIdentifier := map[string]WorkflowNodeResponseSingleSchema{}
+
+	for _, existing := range existingNodes {
+		existingByIdentifier[existing.Identifier] = existing
+	}
+
+	nodeIDs := map[string]int32{}
+
+	for _, node := range graph.Nodes {
+		existing, ok := existingByIdentifier[node.Identifier]
+		changed := false
+
+		if !ok {
+			created, err := workflow.CreateWorkflowNode(id, node.nodeRequest())
+
+			if err != nil {
+				return report, err
+			}
+
+			existing = created
+			report.CreatedNodes = append(report.CreatedNodes, node.Identifier)
+		} else if node.needsUpdate(existing) {
+			if _, err = workflow.ReplaceWorkflowNode(existing.ID, node.replaceRequest(existing)); err != nil {
+				return report, err
+			}
+
+			changed = true
+		}
+
+		if node.Type == NodeTypeApproval && (!ok || node.needsApproval(existing)) {
+			if _, err = workflow.CreateApprovalTemplate(existing.ID, *node.Approval); err != nil {
+				return report, err
+			}
+
+			changed = ok
+		}
+
+		if changed {
+			report.UpdatedNodes = append(report.UpdatedNodes, node.Identifier)
+		}
+
+		nodeIDs[node.Identifier] = existing.ID
+	}
+
+	for _, existing := range existingNodes {
+		if _, ok := nodeIDs[existing.Identifier]; ok {
+			continue
+		}
+
+		if _, err = workflow.DeleteWorkflowNode(existing.ID); err != nil {
+			return report, err
+		}
+
+		report.DeletedNodes = append(report.DeletedNodes, existing.Identifier)
+	}
+
+	removed, added := graph.edgeChanges(existingByIdentifier, nodeIDs)
+
+	for _, edge := range removed {
+		if _, err = workflow.DisassociateWorkflowNode(nodeIDs[edge.From], nodeIDs[edge.To], edge.Type); err != nil {
+			return report, err
+		}
+
+		report.RemovedEdges = append(report.RemovedEdges, edge.String())
+	}
+
+	for _, edge := range added {
+		if _, err = workflow.AssociateWorkflowNode(nodeIDs[edge.From], nodeIDs[edge.To], edge.Type); err != nil {
+			return report, err
+		}
+
+		report.AddedEdges = append(report.AddedEdges, edge.String())
+	}
+
+	return report, nil
+}
+
+// edgeChanges computes the edges to remove and the edges to add to make the existing edges match the graph
+//
+// Every removal has to be made before any addition, AWX rejects a second edge between the same pair of nodes and
+// an edge that closes a cycle with an edge that is about to be removed.
+//
+//	:param existingByIdentifier: The existing nodes by identifier
+//	:param nodeIDs: The IDs of the nodes of the graph by identifier
+func (graph *WorkflowGraph) edgeChanges(existingByIdentifier map[string]WorkflowNodeResponseSingleSchema, nodeIDs map[string]int32) (removed []WorkflowEdge, added []WorkflowEdge) {
+	identifiers := map[int32]string{}
+
+	for identifier, nodeID := range nodeIDs {
+		identifiers[nodeID] = identifier
+	}
+
+	current := []WorkflowEdge{}
+
+	for _, node := range graph.Nodes {
+		existing := existingByIdentifier[node.Identifier]
+
+		existingChildren := map[string][]int32{
+			EdgeSuccess: existing.SuccessNodes,
+			EdgeFailure: existing.FailureNodes,
+			EdgeAlways:  existing.AlwaysNodes,
+		}
+
+		for _, edgeType := range []string{EdgeSuccess, EdgeFailure, EdgeAlways} {
+			for _, childID := range existingChildren[edgeType] {
+				if child, kept := identifiers[childID]; kept {
+					current = append(current, WorkflowEdge{From: node.Identifier, To: child, Type: edgeType})
+				}
+			}
+		}
+	}
+
+	for _, edge := range current {
+		if !slices.Contains(graph.Edges, edge) {
+			removed = append(removed, edge)
+		}
+	}
+
+	for _, edge := range graph.Edges {
+		if !slices.Contains(current, edge) {
+			added = append(added, edge)
+		}
+	}
+
+	return removed, added
+}
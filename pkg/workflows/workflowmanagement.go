@@ -0,0 +1,205 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/connection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/inventories"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/jobs"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/polling"
+	"sync"
+)
+
+// WorkflowManagement represents an AAP workflow management object
+type WorkflowManagement struct {
+	workflow           *WorkflowJobTemplate
+	workflowJobID      int32
+	workflowJobIDMutex sync.Mutex
+	workflowName       string
+	workflowID         int32
+	inventoryName      string
+	inventoryID        int32
+	pollOptions        polling.Options
+	observers          []polling.StatusObserver
+	logger             polling.Logger
+}
+
+// NewWorkflowManagement creates a new workflow management instance
+//
+//	:param basicConnection: The basic connection to use
+//	:param workflowName: The name of the workflow job template
+//	:param inventoryName: The name of the inventory to launch with, empty uses the inventory of the workflow
+func NewWorkflowManagement(basicConnection connection.BasicConnection, workflowName string, inventoryName string) (*WorkflowManagement, error) {
+	var inventoryID int32
+
+	if inventoryName != "" {
+		inventory := inventories.NewInventory(basicConnection)
+
+		id, err := inventory.GetInventoryID(inventoryName)
+
+		if err != nil {
+			return nil, err
+		}
+
+		inventoryID = id
+	}
+
+	workflow := NewWorkflowJobTemplate(basicConnection)
+
+	workflowID, err := workflow.GetWorkflowJobTemplateID(workflowName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &WorkflowManagement{
+		workflow:      workflow,
+		workflowName:  workflowName,
+		workflowID:    workflowID,
+		inventoryName: inventoryName,
+		inventoryID:   inventoryID,
+	}, nil
+}
+
+// Run runs a workflow job, the inventory defaults to the one of the workflow management
+//
+//	:param launchData: The launch data
+func (workflowManagement *WorkflowManagement) Run(launchData WorkflowLaunchRequestSchema) (err error) {
+	if launchData.Inventory == 0 {
+		launchData.Inventory = workflowManagement.inventoryID
+	}
+
+	workflowJob, err := workflowManagement.workflow.LaunchWorkflowJobTemplate(workflowManagement.workflowID, launchData)
+
+	if err != nil {
+		return err
+	}
+
+	workflowManagement.setWorkflowJobID(workflowJob.WorkflowJob)
+
+	return nil
+}
+
+// SetPollOptions sets the options used to poll the launched workflow job, the interval defaults to 5 seconds
+//
+//	:param options: The poll options
+func (workflowManagement *WorkflowManagement) SetPollOptions(options polling.Options) {
+	workflowManagement.pollOptions = options
+}
+
+// AddStatusObserver adds an observer of the status transitions of the launched workflow job
+//
+//	:param observer: The observer to add
+func (workflowManagement *WorkflowManagement) AddStatusObserver(observer polling.StatusObserver) {
+	workflowManagement.observers = append(workflowManagement.observers, observer)
+}
+
+// SetLogger sets the logger used to report polling progress instead of printing to stdout
+//
+//	:param logger: The logger to use, nil stops logging
+func (workflowManagement *WorkflowManagement) SetLogger(logger polling.Logger) {
+	workflowManagement.logger = logger
+}
+
+// PollCompletion runs a workflow job and polls for completion
+//
+// Progress is reported to the logger set with SetLogger, or printed to stdout when printStatus is true and no
+// logger is set.
+//
+//	:param printStatus: Whether to print the status
+//	:param launchData: The launch data
+func (workflowManagement *WorkflowManagement) PollCompletion(printStatus bool, launchData WorkflowLaunchRequestSchema) (workflowStatus string, err error) {
+	if workflowManagement.WorkflowJobID() == 0 {
+		err = workflowManagement.Run(launchData)
+
+		if err != nil {
+			return "new", err
+		}
+	}
+
+	logger := workflowManagement.logger
+
+	if logger == nil && printStatus {
+		logger = polling.StdoutLogger{}
+	}
+
+	return workflowManagement.waitForWorkflowJob(context.Background(), logger)
+}
+
+// Wait polls the launched workflow job until it is finished, the maximum wait is reached or the context is done
+//
+//	:param ctx: The context used to stop waiting
+func (workflowManagement *WorkflowManagement) Wait(ctx context.Context) (workflowStatus string, err error) {
+	return workflowManagement.waitForWorkflowJob(ctx, workflowManagement.logger)
+}
+
+// waitForWorkflowJob polls the launched workflow job with the poll options, notifying the observers of each status
+// transition
+//
+//	:param ctx: The context used to stop waiting
+//	:param logger: The logger to report progress to, nil for none
+func (workflowManagement *WorkflowManagement) waitForWorkflowJob(ctx context.Context, logger polling.Logger) (workflowStatus string, err error) {
+	workflowJobID := workflowManagement.WorkflowJobID()
+
+	if workflowJobID == 0 {
+		return "new", errors.New("no workflow job has been launched")
+	}
+
+	watch := polling.Watch{Label: "Workflow Job", ID: workflowJobID, Observers: workflowManagement.observers, Logger: logger}
+
+	return polling.WaitForStatus(ctx, workflowManagement.pollOptions.WithDefaults(), watch, func() (string, error) {
+		return workflowManagement.workflow.GetWorkflowJobStatus(workflowJobID)
+	})
+}
+
+// GetNodes gets the nodes of the launched workflow job with the status of the job each node ran
+func (workflowManagement *WorkflowManagement) GetNodes() (nodes []WorkflowJobNodeResponseSingleSchema, err error) {
+	workflowJobID := workflowManagement.WorkflowJobID()
+
+	if workflowJobID == 0 {
+		return []WorkflowJobNodeResponseSingleSchema{}, errors.New("no workflow job has been launched")
+	}
+
+	return workflowManagement.workflow.GetWorkflowJobNodes(workflowJobID)
+}
+
+// Abort cancels the launched workflow job if it is still running
+func (workflowManagement *WorkflowManagement) Abort() (err error) {
+	workflowJobID := workflowManagement.WorkflowJobID()
+
+	if workflowJobID == 0 {
+		return errors.New("no workflow job has been launched")
+	}
+
+	status, err := workflowManagement.workflow.GetWorkflowJobStatus(workflowJobID)
+
+	if err != nil {
+		return err
+	}
+
+	if jobs.IsFinishedStatus(status) {
+		return nil
+	}
+
+	_, err = workflowManagement.workflow.CancelWorkflowJob(workflowJobID)
+
+	return err
+}
+
+// WorkflowJobID gets the ID of the launched workflow job, 0 when no workflow job was launched
+func (workflowManagement *WorkflowManagement) WorkflowJobID() int32 {
+	workflowManagement.workflowJobIDMutex.Lock()
+	defer workflowManagement.workflowJobIDMutex.Unlock()
+
+	return workflowManagement.workflowJobID
+}
+
+// setWorkflowJobID sets the ID of the launched workflow job
+//
+//	:param workflowJobID: The ID of the launched workflow job
+func (workflowManagement *WorkflowManagement) setWorkflowJobID(workflowJobID int32) {
+	workflowManagement.workflowJobIDMutex.Lock()
+	defer workflowManagement.workflowJobIDMutex.Unlock()
+
+	workflowManagement.workflowJobID = workflowJobID
+}
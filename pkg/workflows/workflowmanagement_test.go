@@ -0,0 +1,129 @@
+package workflows
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/btr1975/go-ansible-aap-api-client/internal/fakeconnection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/jobs"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/polling"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newFakeWorkflowConnection launches workflow job 30 that goes through a sequence of statuses, one per poll
+func newFakeWorkflowConnection(statuses ...string) *fakeconnection.Connection {
+	polls := 0
+	nodes := []WorkflowJobNodeResponseSingleSchema{{ID: 1}, {ID: 2, DoNotRun: true}, {ID: 3}}
+	nodes[0].SummaryFields.Job.Status = "successful"
+
+	connection := fakeconnection.New().
+		HandleJSON(http.MethodGet, "workflow_jobs/30/workflow_nodes/", WorkflowJobNodeResponseSchema{Count: 3, Results: nodes}).
+		HandleJSON(http.MethodGet, "workflow_jobs/30/cancel/", WorkflowCancelResponseSchema{CanCancel: true}).
+		HandleJSON(http.MethodPost, "workflow_jobs/30/cancel/", map[string]any{}).
+		HandleJSON(http.MethodPost, "workflow_job_templates/4/launch/", WorkflowJobResponseSingleSchema{ID: 30, WorkflowJob: 30, Status: "pending"})
+
+	connection.Handle(http.MethodGet, "workflow_jobs/30/", func(fakeconnection.Request) (*http.Response, error) {
+		status := statuses[min(polls, len(statuses)-1)]
+		polls++
+
+		return fakeconnection.JSON(WorkflowJobResponseSingleSchema{ID: 30, Status: status})
+	})
+
+	return connection
+}
+
+func newTestWorkflowManagement(statuses ...string) (*WorkflowManagement, *fakeconnection.Connection) {
+	connection := newFakeWorkflowConnection(statuses...)
+
+	return &WorkflowManagement{workflow: NewWorkflowJobTemplate(connection), workflowID: 4}, connection
+}
+
+func TestWorkflowManagement_PollCompletion(t *testing.T) {
+	workflowManagement, connection := newTestWorkflowManagement("pending", "running", "running", "successful")
+	workflowManagement.SetPollOptions(polling.Options{Interval: time.Millisecond})
+
+	transitions := []string{}
+	workflowManagement.AddStatusObserver(polling.StatusObserverFunc(func(transition polling.StatusTransition) {
+		transitions = append(transitions, transition.From+">"+transition.To)
+	}))
+
+	output := &bytes.Buffer{}
+	workflowManagement.SetLogger(log.New(output, "", 0))
+
+	status, err := workflowManagement.PollCompletion(false, WorkflowLaunchRequestSchema{})
+
+	if err != nil || status != "successful" {
+		t.Fatalf("WorkflowManagement.PollCompletion() = %s, %v, want successful", status, err)
+	}
+
+	if !slices.Equal(connection.Calls(http.MethodPost), []string{"POST workflow_job_templates/4/launch/"}) || workflowManagement.WorkflowJobID() != 30 {
+		t.Errorf("WorkflowManagement.PollCompletion() posts = %v, workflow job = %d", connection.Calls(http.MethodPost), workflowManagement.WorkflowJobID())
+	}
+
+	want := "new>pending,pending>running,running>successful"
+
+	if strings.Join(transitions, ",") != want {
+		t.Errorf("WorkflowManagement.PollCompletion() transitions = %v, want %s", transitions, want)
+	}
+
+	if !strings.Contains(output.String(), "Polling Workflow Job ID 30 completed status successful") {
+		t.Errorf("WorkflowManagement.PollCompletion() log = %q", output.String())
+	}
+}
+
+func TestWorkflowManagement_Wait(t *testing.T) {
+	workflowManagement, _ := newTestWorkflowManagement("running")
+	workflowManagement.SetPollOptions(polling.Options{Interval: time.Millisecond, MaxWait: 20 * time.Millisecond})
+
+	if _, err := workflowManagement.Wait(context.Background()); err == nil {
+		t.Errorf("WorkflowManagement.Wait() error = nil, want no workflow job error")
+	}
+
+	workflowManagement.setWorkflowJobID(30)
+
+	status, err := workflowManagement.Wait(context.Background())
+
+	if !errors.Is(err, polling.ErrTimeout) || status != "running" {
+		t.Errorf("WorkflowManagement.Wait() = %s, %v, want running and ErrTimeout", status, err)
+	}
+}
+
+func TestWorkflowManagement_GetNodesAndAbort(t *testing.T) {
+	workflowManagement, connection := newTestWorkflowManagement("running")
+	workflowManagement.setWorkflowJobID(30)
+
+	nodes, err := workflowManagement.GetNodes()
+
+	if err != nil {
+		t.Fatalf("WorkflowManagement.GetNodes() error = %v", err)
+	}
+
+	got := []string{}
+
+	for _, node := range nodes {
+		got = append(got, node.JobStatus())
+	}
+
+	if !slices.Equal(got, []string{"successful", "do not run", "not started"}) {
+		t.Errorf("WorkflowJobNodeResponseSingleSchema.JobStatus() = %v", got)
+	}
+
+	if err = workflowManagement.Abort(); err != nil || !slices.Equal(connection.Calls(http.MethodPost), []string{"POST workflow_jobs/30/cancel/"}) {
+		t.Errorf("WorkflowManagement.Abort() error = %v, posts = %v", err, connection.Calls(http.MethodPost))
+	}
+}
+
+func TestWorkflowJobTemplate_CancelWorkflowJob(t *testing.T) {
+	connection := fakeconnection.New().HandleJSON(http.MethodGet, "workflow_jobs/30/cancel/", WorkflowCancelResponseSchema{CanCancel: false})
+
+	_, err := NewWorkflowJobTemplate(connection).CancelWorkflowJob(30)
+
+	if !errors.Is(err, jobs.ErrJobNotCancelable) || len(connection.Calls(http.MethodPost)) != 0 {
+		t.Errorf("WorkflowJobTemplate.CancelWorkflowJob() error = %v, posts = %v, want ErrJobNotCancelable", err, connection.Calls(http.MethodPost))
+	}
+}
@@ -0,0 +1,367 @@
+/*
+Package workflows provides a way to manipulate workflow job templates and workflow jobs for Ansible AAP
+*/
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/common"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/connection"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/dataconversion"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/jobs"
+	"github.com/btr1975/go-ansible-aap-api-client/pkg/polling"
+)
+
+// WorkflowJobTemplate represents an AAP workflow job template
+type WorkflowJobTemplate struct {
+	URI                 string
+	WorkflowJobURI      string
+	WorkflowNodeURI     string
+	WorkflowApprovalURI string
+	connection          connection.BasicConnection
+	DataConversion      dataconversion.DataConverterInterface
+}
+
+// NewWorkflowJobTemplate creates a new workflow job template instance
+//
+//	:param basicConnection: The basic connection to use
+func NewWorkflowJobTemplate(basicConnection connection.BasicConnection) *WorkflowJobTemplate {
+	return &WorkflowJobTemplate{
+		URI:                 "workflow_job_templates/",
+		WorkflowJobURI:      "workflow_jobs/",
+		WorkflowNodeURI:     "workflow_job_template_nodes/",
+		WorkflowApprovalURI: "workflow_approvals/",
+		connection:          basicConnection,
+		DataConversion:      dataconversion.NewDataConverter(),
+	}
+}
+
+// GetAllWorkflowJobTemplates gets all workflow job templates
+func (workflow *WorkflowJobTemplate) GetAllWorkflowJobTemplates() (schemaResponse WorkflowJobTemplateResponseSchema, err error) {
+	schemaResponse = WorkflowJobTemplateResponseSchema{}
+
+	response, err := workflow.connection.Get(workflow.URI, nil)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = workflow.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// GetWorkflowJobTemplate gets a workflow job template by name
+//
+//	:param name: The name of the workflow job template to get
+func (workflow *WorkflowJobTemplate) GetWorkflowJobTemplate(name string) (schemaResponse WorkflowJobTemplateResponseSchema, err error) {
+	schemaResponse = WorkflowJobTemplateResponseSchema{}
+
+	params := map[string]string{
+		"name": name,
+	}
+
+	response, err := workflow.connection.Get(workflow.URI, params)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = workflow.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// GetWorkflowJobTemplateID gets a workflow job template ID by name
+//
+//	:param name: The name of the workflow job template to get
+func (workflow *WorkflowJobTemplate) GetWorkflowJobTemplateID(name string) (id int32, err error) {
+	schemaResponse, err := workflow.GetWorkflowJobTemplate(name)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if len(schemaResponse.Results) > 1 {
+		return 0, fmt.Errorf("more than one workflow job template found with name %s", name)
+	}
+
+	if len(schemaResponse.Results) == 0 {
+		return 0, fmt.Errorf("no workflow job template found with name %s", name)
+	}
+
+	return schemaResponse.Results[0].ID, nil
+}
+
+// GetWorkflowJobTemplateByID gets a workflow job template by ID
+//
+//	:param id: The ID of the workflow job template to get
+func (workflow *WorkflowJobTemplate) GetWorkflowJobTemplateByID(id int32) (schemaResponse WorkflowJobTemplateResponseSingleSchema, err error) {
+	schemaResponse = WorkflowJobTemplateResponseSingleSchema{}
+
+	uri := fmt.Sprintf("%s%d/", workflow.URI, id)
+
+	response, err := workflow.connection.Get(uri, nil)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = workflow.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// CreateWorkflowJobTemplate creates a new workflow job template
+//
+//	:param workflowRequest: The workflow job template request schema to use
+func (workflow *WorkflowJobTemplate) CreateWorkflowJobTemplate(workflowRequest WorkflowJobTemplateRequestSchema) (schemaResponse WorkflowJobTemplateResponseSingleSchema, err error) {
+	schemaResponse = WorkflowJobTemplateResponseSingleSchema{}
+
+	data, err := json.Marshal(workflowRequest)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	response, err := workflow.connection.Post(workflow.URI, data)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = workflow.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// UpdateWorkflowJobTemplate updates a workflow job template by ID
+//
+//	:param id: The ID of the workflow job template to update
+//	:param workflowRequest: The workflow job template request schema to use
+func (workflow *WorkflowJobTemplate) UpdateWorkflowJobTemplate(id int32, workflowRequest WorkflowJobTemplateRequestSchema) (schemaResponse WorkflowJobTemplateResponseSingleSchema, err error) {
+	schemaResponse = WorkflowJobTemplateResponseSingleSchema{}
+
+	uri := fmt.Sprintf("%s%d/", workflow.URI, id)
+
+	data, err := json.Marshal(workflowRequest)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	response, err := workflow.connection.Patch(uri, data)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = workflow.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// DeleteWorkflowJobTemplate deletes a workflow job template by ID
+//
+//	:param id: The ID of the workflow job template to delete
+func (workflow *WorkflowJobTemplate) DeleteWorkflowJobTemplate(id int32) (statusCode int, err error) {
+	uri := fmt.Sprintf("%s%d/", workflow.URI, id)
+
+	response, err := workflow.connection.Delete(uri, nil)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response.StatusCode, nil
+}
+
+// GetLaunchInfo gets the launch metadata of a workflow job template by ID
+//
+//	:param id: The ID of the workflow job template
+func (workflow *WorkflowJobTemplate) GetLaunchInfo(id int32) (schemaResponse WorkflowLaunchInfoSchema, err error) {
+	schemaResponse = WorkflowLaunchInfoSchema{}
+
+	uri := fmt.Sprintf("%s%d/launch/", workflow.URI, id)
+
+	response, err := workflow.connection.Get(uri, nil)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = workflow.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// LaunchWorkflowJobTemplate launches a workflow job template by ID
+//
+//	:param id: The ID of the workflow job template to launch
+//	:param launchData: The launch data with the prompts to answer
+func (workflow *WorkflowJobTemplate) LaunchWorkflowJobTemplate(id int32, launchData WorkflowLaunchRequestSchema) (schemaResponse WorkflowJobResponseSingleSchema, err error) {
+	schemaResponse = WorkflowJobResponseSingleSchema{}
+
+	uri := fmt.Sprintf("%s%d/launch/", workflow.URI, id)
+
+	data, err := json.Marshal(launchData)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	response, err := workflow.connection.Post(uri, data)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = workflow.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	if schemaResponse.WorkflowJob == 0 {
+		schemaResponse.WorkflowJob = schemaResponse.ID
+	}
+
+	return schemaResponse, nil
+}
+
+// GetWorkflowJob gets a workflow job by ID
+//
+//	:param id: The ID of the workflow job to get
+func (workflow *WorkflowJobTemplate) GetWorkflowJob(id int32) (schemaResponse WorkflowJobResponseSingleSchema, err error) {
+	schemaResponse = WorkflowJobResponseSingleSchema{}
+
+	uri := fmt.Sprintf("%s%d/", workflow.WorkflowJobURI, id)
+
+	response, err := workflow.connection.Get(uri, nil)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	err = workflow.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return schemaResponse, err
+	}
+
+	return schemaResponse, nil
+}
+
+// GetWorkflowJobStatus gets the status of a workflow job by ID
+//
+//	:param id: The ID of the workflow job to get the status for
+func (workflow *WorkflowJobTemplate) GetWorkflowJobStatus(id int32) (status string, err error) {
+	response, err := workflow.GetWorkflowJob(id)
+
+	if err != nil {
+		return "", err
+	}
+
+	if response.Status == "" {
+		return "", fmt.Errorf("status not found for workflow job %d", id)
+	}
+
+	return response.Status, nil
+}
+
+// CancelWorkflowJob cancels a workflow job by ID
+//
+//	:param id: The ID of the workflow job to cancel
+func (workflow *WorkflowJobTemplate) CancelWorkflowJob(id int32) (statusCode int, err error) {
+	schemaResponse := WorkflowCancelResponseSchema{}
+
+	uri := fmt.Sprintf("%s%d/cancel/", workflow.WorkflowJobURI, id)
+
+	response, err := workflow.connection.Get(uri, nil)
+
+	if err != nil {
+		return 0, err
+	}
+
+	err = workflow.DataConversion.ResponseBodyToStruct(&schemaResponse, *response)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if !schemaResponse.CanCancel {
+		return 0, fmt.Errorf("workflow job %d: %w", id, jobs.ErrJobNotCancelable)
+	}
+
+	response, err = workflow.connection.Post(uri, []byte("{}"))
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer response.Body.Close()
+
+	return response.StatusCode, nil
+}
+
+// WaitForWorkflowJob polls a workflow job until it reaches a finished status
+//
+//	:param ctx: The context used to stop waiting
+//	:param id: The ID of the workflow job to wait for
+//	:param options: The poll options, the interval must be positive
+func (workflow *WorkflowJobTemplate) WaitForWorkflowJob(ctx context.Context, id int32, options polling.Options) (status string, err error) {
+	watch := polling.Watch{Label: "Workflow Job", ID: id}
+
+	return polling.WaitForStatus(ctx, options, watch, func() (string, error) {
+		return workflow.GetWorkflowJobStatus(id)
+	})
+}
+
+// GetWorkflowJobNodes gets every node of a workflow job with the status of the job it ran
+//
+//	:param id: The ID of the workflow job
+func (workflow *WorkflowJobTemplate) GetWorkflowJobNodes(id int32) (nodes []WorkflowJobNodeResponseSingleSchema, err error) {
+	uri := fmt.Sprintf("%s%d/workflow_nodes/", workflow.WorkflowJobURI, id)
+
+	return common.GetAllPages[WorkflowJobNodeResponseSingleSchema](workflow.connection, workflow.DataConversion, uri, nil)
+}
+
+// JobStatus gets the status of the job a workflow job node ran, "do not run" for a node that was skipped
+// and "not started" for a node whose job has not been created yet
+func (node WorkflowJobNodeResponseSingleSchema) JobStatus() string {
+	if node.SummaryFields.Job.Status != "" {
+		return node.SummaryFields.Job.Status
+	}
+
+	if node.DoNotRun {
+		return "do not run"
+	}
+
+	return "not started"
+}
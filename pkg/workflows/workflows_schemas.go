@@ -0,0 +1,242 @@
+package workflows
+
+// WorkflowJobTemplateRequestSchema is the schema for a workflow job template request
+type WorkflowJobTemplateRequestSchema struct {
+	Name                 string `json:"name" yaml:"name"`
+	Description          string `json:"description" yaml:"description"`
+	ExtraVars            string `json:"extra_vars" yaml:"extra_vars"`
+	Organization         int32  `json:"organization,omitempty" yaml:"organization,omitempty"`
+	SurveyEnabled        bool   `json:"survey_enabled" yaml:"survey_enabled"`
+	AllowSimultaneous    bool   `json:"allow_simultaneous" yaml:"allow_simultaneous"`
+	AskVariablesOnLaunch bool   `json:"ask_variables_on_launch" yaml:"ask_variables_on_launch"`
+	Inventory            int32  `json:"inventory,omitempty" yaml:"inventory,omitempty"`
+	Limit                string `json:"limit" yaml:"limit"`
+	ScmBranch            string `json:"scm_branch" yaml:"scm_branch"`
+	JobTags              string `json:"job_tags" yaml:"job_tags"`
+	SkipTags             string `json:"skip_tags" yaml:"skip_tags"`
+	AskInventoryOnLaunch bool   `json:"ask_inventory_on_launch" yaml:"ask_inventory_on_launch"`
+	AskScmBranchOnLaunch bool   `json:"ask_scm_branch_on_launch" yaml:"ask_scm_branch_on_launch"`
+	AskLimitOnLaunch     bool   `json:"ask_limit_on_launch" yaml:"ask_limit_on_launch"`
+	AskLabelsOnLaunch    bool   `json:"ask_labels_on_launch" yaml:"ask_labels_on_launch"`
+	AskTagsOnLaunch      bool   `json:"ask_tags_on_launch" yaml:"ask_tags_on_launch"`
+	AskSkipTagsOnLaunch  bool   `json:"ask_skip_tags_on_launch" yaml:"ask_skip_tags_on_launch"`
+	WebhookService       string `json:"webhook_service" yaml:"webhook_service"`
+	WebhookCredential    int32  `json:"webhook_credential,omitempty" yaml:"webhook_credential,omitempty"`
+}
+
+// WorkflowJobTemplateRelatedResponseSchema is the schema for the related section of a response
+type WorkflowJobTemplateRelatedResponseSchema struct {
+	CreatedBy                     string `json:"created_by" yaml:"created_by"`
+	ModifiedBy                    string `json:"modified_by" yaml:"modified_by"`
+	LastJob                       string `json:"last_job" yaml:"last_job"`
+	WorkflowJobs                  string `json:"workflow_jobs" yaml:"workflow_jobs"`
+	Schedules                     string `json:"schedules" yaml:"schedules"`
+	Launch                        string `json:"launch" yaml:"launch"`
+	WorkflowNodes                 string `json:"workflow_nodes" yaml:"workflow_nodes"`
+	Labels                        string `json:"labels" yaml:"labels"`
+	ActivityStream                string `json:"activity_stream" yaml:"activity_stream"`
+	NotificationTemplatesStarted  string `json:"notification_templates_started" yaml:"notification_templates_started"`
+	NotificationTemplatesSuccess  string `json:"notification_templates_success" yaml:"notification_templates_success"`
+	NotificationTemplatesError    string `json:"notification_templates_error" yaml:"notification_templates_error"`
+	NotificationTemplatesApproval string `json:"notification_templates_approvals" yaml:"notification_templates_approvals"`
+	AccessList                    string `json:"access_list" yaml:"access_list"`
+	ObjectRoles                   string `json:"object_roles" yaml:"object_roles"`
+	SurveySpec                    string `json:"survey_spec" yaml:"survey_spec"`
+	Copy                          string `json:"copy" yaml:"copy"`
+	Organization                  string `json:"organization" yaml:"organization"`
+	Inventory                     string `json:"inventory" yaml:"inventory"`
+}
+
+// WorkflowJobTemplateResponseSingleSchema is the schema for a single workflow job template response item
+type WorkflowJobTemplateResponseSingleSchema struct {
+	ID      int32                                    `json:"id" yaml:"id"`
+	Type    string                                   `json:"type" yaml:"type"`
+	URL     string                                   `json:"url" yaml:"url"`
+	Related WorkflowJobTemplateRelatedResponseSchema `json:"related" yaml:"related"`
+	WorkflowJobTemplateRequestSchema
+	Created       string `json:"created" yaml:"created"`
+	Modified      string `json:"modified" yaml:"modified"`
+	LastJobRun    string `json:"last_job_run" yaml:"last_job_run"`
+	LastJobFailed bool   `json:"last_job_failed" yaml:"last_job_failed"`
+	NextJobRun    string `json:"next_job_run" yaml:"next_job_run"`
+	Status        string `json:"status" yaml:"status"`
+}
+
+// WorkflowJobTemplateResponseSchema is the schema for a workflow job template response
+type WorkflowJobTemplateResponseSchema struct {
+	Count    int32                                     `json:"count" yaml:"count"`
+	Next     string                                    `json:"next" yaml:"next"`
+	Previous string                                    `json:"previous" yaml:"previous"`
+	Results  []WorkflowJobTemplateResponseSingleSchema `json:"results" yaml:"results"`
+}
+
+// WorkflowLaunchRequestSchema is the schema for a workflow job template launch request
+//
+// Only the fields that are set are sent, so a prompt that is not asked on launch can be left empty.
+type WorkflowLaunchRequestSchema struct {
+	ExtraVars map[string]any `json:"extra_vars,omitempty" yaml:"extra_vars,omitempty"`
+	Inventory int32          `json:"inventory,omitempty" yaml:"inventory,omitempty"`
+	Limit     string         `json:"limit,omitempty" yaml:"limit,omitempty"`
+	ScmBranch string         `json:"scm_branch,omitempty" yaml:"scm_branch,omitempty"`
+	Labels    []int32        `json:"labels,omitempty" yaml:"labels,omitempty"`
+	JobTags   string         `json:"job_tags,omitempty" yaml:"job_tags,omitempty"`
+	SkipTags  string         `json:"skip_tags,omitempty" yaml:"skip_tags,omitempty"`
+}
+
+// WorkflowLaunchInfoSchema is the schema for the launch metadata returned by a GET of the launch endpoint
+type WorkflowLaunchInfoSchema struct {
+	CanStartWithoutUserInput bool     `json:"can_start_without_user_input" yaml:"can_start_without_user_input"`
+	VariablesNeededToStart   []string `json:"variables_needed_to_start" yaml:"variables_needed_to_start"`
+	SurveyEnabled            bool     `json:"survey_enabled" yaml:"survey_enabled"`
+	AskVariablesOnLaunch     bool     `json:"ask_variables_on_launch" yaml:"ask_variables_on_launch"`
+	AskInventoryOnLaunch     bool     `json:"ask_inventory_on_launch" yaml:"ask_inventory_on_launch"`
+	AskScmBranchOnLaunch     bool     `json:"ask_scm_branch_on_launch" yaml:"ask_scm_branch_on_launch"`
+	AskLimitOnLaunch         bool     `json:"ask_limit_on_launch" yaml:"ask_limit_on_launch"`
+	AskLabelsOnLaunch        bool     `json:"ask_labels_on_launch" yaml:"ask_labels_on_launch"`
+	AskTagsOnLaunch          bool     `json:"ask_tags_on_launch" yaml:"ask_tags_on_launch"`
+	AskSkipTagsOnLaunch      bool     `json:"ask_skip_tags_on_launch" yaml:"ask_skip_tags_on_launch"`
+	NodeTemplatesMissing     []int32  `json:"node_templates_missing" yaml:"node_templates_missing"`
+	NodePromptsRejected      []int32  `json:"node_prompts_rejected" yaml:"node_prompts_rejected"`
+}
+
+// WorkflowJobResponseSingleSchema is the schema for a single workflow job response item
+type WorkflowJobResponseSingleSchema struct {
+	ID                  int32   `json:"id" yaml:"id"`
+	WorkflowJob         int32   `json:"workflow_job" yaml:"workflow_job"`
+	Type                string  `json:"type" yaml:"type"`
+	URL                 string  `json:"url" yaml:"url"`
+	Created             string  `json:"created" yaml:"created"`
+	Modified            string  `json:"modified" yaml:"modified"`
+	Name                string  `json:"name" yaml:"name"`
+	Description         string  `json:"description" yaml:"description"`
+	WorkflowJobTemplate int32   `json:"workflow_job_template" yaml:"workflow_job_template"`
+	LaunchType          string  `json:"launch_type" yaml:"launch_type"`
+	Status              string  `json:"status" yaml:"status"`
+	Failed              bool    `json:"failed" yaml:"failed"`
+	Started             string  `json:"started" yaml:"started"`
+	Finished            string  `json:"finished" yaml:"finished"`
+	CanceledOn          string  `json:"canceled_on" yaml:"canceled_on"`
+	Elapsed             float32 `json:"elapsed" yaml:"elapsed"`
+	JobExplanation      string  `json:"job_explanation" yaml:"job_explanation"`
+	ExtraVars           string  `json:"extra_vars" yaml:"extra_vars"`
+	Inventory           int32   `json:"inventory" yaml:"inventory"`
+	Limit               string  `json:"limit" yaml:"limit"`
+	ScmBranch           string  `json:"scm_branch" yaml:"scm_branch"`
+	IsSlicedJob         bool    `json:"is_sliced_job" yaml:"is_sliced_job"`
+}
+
+// WorkflowJobResponseSchema is the schema for a workflow job response
+type WorkflowJobResponseSchema struct {
+	Count    int32                             `json:"count" yaml:"count"`
+	Next     string                            `json:"next" yaml:"next"`
+	Previous string                            `json:"previous" yaml:"previous"`
+	Results  []WorkflowJobResponseSingleSchema `json:"results" yaml:"results"`
+}
+
+// WorkflowNodeJobSummarySchema is the schema for the summary of the job of a workflow job node
+type WorkflowNodeJobSummarySchema struct {
+	ID          int32   `json:"id" yaml:"id"`
+	Name        string  `json:"name" yaml:"name"`
+	Description string  `json:"description" yaml:"description"`
+	Status      string  `json:"status" yaml:"status"`
+	Failed      bool    `json:"failed" yaml:"failed"`
+	Elapsed     float32 `json:"elapsed" yaml:"elapsed"`
+	Type        string  `json:"type" yaml:"type"`
+}
+
+// WorkflowNodeTemplateSummarySchema is the schema for the summary of the unified job template of a workflow node
+type WorkflowNodeTemplateSummarySchema struct {
+	ID             int32  `json:"id" yaml:"id"`
+	Name           string `json:"name" yaml:"name"`
+	Description    string `json:"description" yaml:"description"`
+	UnifiedJobType string `json:"unified_job_type" yaml:"unified_job_type"`
+	// Timeout is the timeout in seconds of an approval template
+	Timeout int32 `json:"timeout" yaml:"timeout"`
+}
+
+// WorkflowJobNodeSummaryFieldsSchema is the schema for the summary fields of a workflow job node
+type WorkflowJobNodeSummaryFieldsSchema struct {
+	Job                WorkflowNodeJobSummarySchema      `json:"job" yaml:"job"`
+	UnifiedJobTemplate WorkflowNodeTemplateSummarySchema `json:"unified_job_template" yaml:"unified_job_template"`
+}
+
+// WorkflowJobNodeResponseSingleSchema is the schema for a single workflow job node response item
+type WorkflowJobNodeResponseSingleSchema struct {
+	ID                     int32                              `json:"id" yaml:"id"`
+	Type                   string                             `json:"type" yaml:"type"`
+	URL                    string                             `json:"url" yaml:"url"`
+	Created                string                             `json:"created" yaml:"created"`
+	Modified               string                             `json:"modified" yaml:"modified"`
+	SummaryFields          WorkflowJobNodeSummaryFieldsSchema `json:"summary_fields" yaml:"summary_fields"`
+	Job                    int32                              `json:"job" yaml:"job"`
+	WorkflowJob            int32                              `json:"workflow_job" yaml:"workflow_job"`
+	UnifiedJobTemplate     int32                              `json:"unified_job_template" yaml:"unified_job_template"`
+	SuccessNodes           []int32                            `json:"success_nodes" yaml:"success_nodes"`
+	FailureNodes           []int32                            `json:"failure_nodes" yaml:"failure_nodes"`
+	AlwaysNodes            []int32                            `json:"always_nodes" yaml:"always_nodes"`
+	AllParentsMustConverge bool                               `json:"all_parents_must_converge" yaml:"all_parents_must_converge"`
+	DoNotRun               bool                               `json:"do_not_run" yaml:"do_not_run"`
+	Identifier             string                             `json:"identifier" yaml:"identifier"`
+}
+
+// WorkflowJobNodeResponseSchema is the schema for a workflow job node response
+type WorkflowJobNodeResponseSchema struct {
+	Count    int32                                 `json:"count" yaml:"count"`
+	Next     string                                `json:"next" yaml:"next"`
+	Previous string                                `json:"previous" yaml:"previous"`
+	Results  []WorkflowJobNodeResponseSingleSchema `json:"results" yaml:"results"`
+}
+
+// WorkflowCancelResponseSchema is the schema for the response of a GET of the cancel endpoint
+type WorkflowCancelResponseSchema struct {
+	CanCancel bool `json:"can_cancel" yaml:"can_cancel"`
+}
+
+// WorkflowApprovalUserSummarySchema is the schema for the summary of the user that approved or denied an approval
+type WorkflowApprovalUserSummarySchema struct {
+	ID       int32  `json:"id" yaml:"id"`
+	Username string `json:"username" yaml:"username"`
+}
+
+// WorkflowApprovalSourceSummarySchema is the schema for the summary of the workflow job of an approval
+type WorkflowApprovalSourceSummarySchema struct {
+	ID     int32  `json:"id" yaml:"id"`
+	Name   string `json:"name" yaml:"name"`
+	Status string `json:"status" yaml:"status"`
+}
+
+// WorkflowApprovalSummaryFieldsSchema is the schema for the summary fields of a workflow approval
+type WorkflowApprovalSummaryFieldsSchema struct {
+	SourceWorkflowJob  WorkflowApprovalSourceSummarySchema `json:"source_workflow_job" yaml:"source_workflow_job"`
+	ApprovedOrDeniedBy WorkflowApprovalUserSummarySchema   `json:"approved_or_denied_by" yaml:"approved_or_denied_by"`
+}
+
+// WorkflowApprovalResponseSingleSchema is the schema for a single workflow approval response item
+type WorkflowApprovalResponseSingleSchema struct {
+	ID                 int32                               `json:"id" yaml:"id"`
+	Type               string                              `json:"type" yaml:"type"`
+	URL                string                              `json:"url" yaml:"url"`
+	SummaryFields      WorkflowApprovalSummaryFieldsSchema `json:"summary_fields" yaml:"summary_fields"`
+	Created            string                              `json:"created" yaml:"created"`
+	Modified           string                              `json:"modified" yaml:"modified"`
+	Name               string                              `json:"name" yaml:"name"`
+	Description        string                              `json:"description" yaml:"description"`
+	UnifiedJobTemplate int32                               `json:"unified_job_template" yaml:"unified_job_template"`
+	Status             string                              `json:"status" yaml:"status"`
+	Failed             bool                                `json:"failed" yaml:"failed"`
+	Started            string                              `json:"started" yaml:"started"`
+	Finished           string                              `json:"finished" yaml:"finished"`
+	Elapsed            float32                             `json:"elapsed" yaml:"elapsed"`
+	JobExplanation     string                              `json:"job_explanation" yaml:"job_explanation"`
+	CanApproveOrDeny   bool                                `json:"can_approve_or_deny" yaml:"can_approve_or_deny"`
+	ApprovalExpiration string                              `json:"approval_expiration" yaml:"approval_expiration"`
+	TimedOut           bool                                `json:"timed_out" yaml:"timed_out"`
+}
+
+// WorkflowApprovalResponseSchema is the schema for a workflow approval response
+type WorkflowApprovalResponseSchema struct {
+	Count    int32                                  `json:"count" yaml:"count"`
+	Next     string                                 `json:"next" yaml:"next"`
+	Previous string                                 `json:"previous" yaml:"previous"`
+	Results  []WorkflowApprovalResponseSingleSchema `json:"results" yaml:"results"`
+}